@@ -8,7 +8,9 @@ Features and Components
 
 -   Grid system with rows and columns
 
--   Automatic page breaks
+-   Automatic page breaks, manual page breaks and Rows kept with the next one
+
+-   Rows repeated at the top and at the bottom of every page
 
 -   Inclusion of JPEG, PNG, GIF, TIFF and basic path-only SVG images
 
@@ -20,6 +22,56 @@ Features and Components
 
 -   Signatures
 
+-   UTF-8 fonts, right-to-left and bidirectional texts
+
+-   Hyperlinks and internal anchors
+
+-   Bookmarks
+
+-   Table of contents with page numbers
+
+-   Numbered headings
+
+-   Bullet and numbered lists
+
+-   Footnotes and endnotes
+
+-   Cross-references to pages and sections
+
+-   Alphabetical index
+
+-   Tab stops and dot leaders
+
+-   Superscript, subscript and small caps
+
+-   Text fitting: max lines, ellipsis and shrink-to-fit
+
+-   Horizontal and vertical alignment of texts, images and codes inside a cell
+
+-   Rotated texts, images and codes
+
+-   Underline, strikethrough and overline, letter spacing and line height
+
+-   Table column widths: fixed, proportional and auto-fit
+
+-   Table header repeated in every page
+
+-   Table cells merged by columns and rows
+
+-   Table footer with totals carried forward
+
+-   Table cells with images, barcodes, QR codes, checkboxes and styled texts
+
+-   Table cell styles and conditional formatting
+
+-   Table borders: horizontal rules, grid, box and per-side lines
+
+-   Table from a slice of structs, with columns defined by struct tags
+
+-   Table streamed from a CSV or an iterator
+
+-   Table contents grouped with headers and subtotals
+
 JustPdf has only gofpdf dependency. All tests pass on Linux and Mac.
 
 
@@ -0,0 +1,498 @@
+package internal
+
+import (
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Bidi is the abstraction which deals of how to prepare right-to-left texts to be written
+type Bidi interface {
+	Shape(text string) string
+	GetDirection(text string, direction consts.Direction) consts.Direction
+	Reorder(line string, direction consts.Direction) string
+}
+
+// bidiClass is the bidirectional category of a character, a subset of Unicode bidi classes
+type bidiClass int
+
+const (
+	bidiL   bidiClass = iota // Left-to-right letter
+	bidiR                    // Right-to-left letter (Hebrew)
+	bidiAL                   // Arabic letter
+	bidiEN                   // European number
+	bidiES                   // European number separator
+	bidiET                   // European number terminator
+	bidiAN                   // Arabic number
+	bidiCS                   // Common number separator
+	bidiNSM                  // Non-spacing mark
+	bidiWS                   // Whitespace
+	bidiON                   // Other neutral
+)
+
+// arabicForms are the presentation forms of an Arabic letter: isolated, final,
+// initial and medial. Letters which only join to the previous one have no
+// initial and medial forms
+type arabicForms [4]rune
+
+const (
+	isolatedForm = iota
+	finalForm
+	initialForm
+	medialForm
+)
+
+const (
+	arabicLam     = 'ل'
+	arabicTatweel = 'ـ'
+)
+
+var arabicLetters = map[rune]arabicForms{
+	'ء': {0xFE80, 0, 0, 0},
+	'آ': {0xFE81, 0xFE82, 0, 0},
+	'أ': {0xFE83, 0xFE84, 0, 0},
+	'ؤ': {0xFE85, 0xFE86, 0, 0},
+	'إ': {0xFE87, 0xFE88, 0, 0},
+	'ئ': {0xFE89, 0xFE8A, 0xFE8B, 0xFE8C},
+	'ا': {0xFE8D, 0xFE8E, 0, 0},
+	'ب': {0xFE8F, 0xFE90, 0xFE91, 0xFE92},
+	'ة': {0xFE93, 0xFE94, 0, 0},
+	'ت': {0xFE95, 0xFE96, 0xFE97, 0xFE98},
+	'ث': {0xFE99, 0xFE9A, 0xFE9B, 0xFE9C},
+	'ج': {0xFE9D, 0xFE9E, 0xFE9F, 0xFEA0},
+	'ح': {0xFEA1, 0xFEA2, 0xFEA3, 0xFEA4},
+	'خ': {0xFEA5, 0xFEA6, 0xFEA7, 0xFEA8},
+	'د': {0xFEA9, 0xFEAA, 0, 0},
+	'ذ': {0xFEAB, 0xFEAC, 0, 0},
+	'ر': {0xFEAD, 0xFEAE, 0, 0},
+	'ز': {0xFEAF, 0xFEB0, 0, 0},
+	'س': {0xFEB1, 0xFEB2, 0xFEB3, 0xFEB4},
+	'ش': {0xFEB5, 0xFEB6, 0xFEB7, 0xFEB8},
+	'ص': {0xFEB9, 0xFEBA, 0xFEBB, 0xFEBC},
+	'ض': {0xFEBD, 0xFEBE, 0xFEBF, 0xFEC0},
+	'ط': {0xFEC1, 0xFEC2, 0xFEC3, 0xFEC4},
+	'ظ': {0xFEC5, 0xFEC6, 0xFEC7, 0xFEC8},
+	'ع': {0xFEC9, 0xFECA, 0xFECB, 0xFECC},
+	'غ': {0xFECD, 0xFECE, 0xFECF, 0xFED0},
+	'ف': {0xFED1, 0xFED2, 0xFED3, 0xFED4},
+	'ق': {0xFED5, 0xFED6, 0xFED7, 0xFED8},
+	'ك': {0xFED9, 0xFEDA, 0xFEDB, 0xFEDC},
+	'ل': {0xFEDD, 0xFEDE, 0xFEDF, 0xFEE0},
+	'م': {0xFEE1, 0xFEE2, 0xFEE3, 0xFEE4},
+	'ن': {0xFEE5, 0xFEE6, 0xFEE7, 0xFEE8},
+	'ه': {0xFEE9, 0xFEEA, 0xFEEB, 0xFEEC},
+	'و': {0xFEED, 0xFEEE, 0, 0},
+	'ى': {0xFEEF, 0xFEF0, 0, 0},
+	'ي': {0xFEF1, 0xFEF2, 0xFEF3, 0xFEF4},
+	'پ': {0xFB56, 0xFB57, 0xFB58, 0xFB59},
+	'چ': {0xFB7A, 0xFB7B, 0xFB7C, 0xFB7D},
+	'ژ': {0xFB8A, 0xFB8B, 0, 0},
+	'ک': {0xFB8E, 0xFB8F, 0xFB90, 0xFB91},
+	'گ': {0xFB92, 0xFB93, 0xFB94, 0xFB95},
+	'ی': {0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF},
+}
+
+// lamAlefLigatures are the isolated and final forms of lam followed by an alef
+var lamAlefLigatures = map[rune][2]rune{
+	'آ': {0xFEF5, 0xFEF6},
+	'أ': {0xFEF7, 0xFEF8},
+	'إ': {0xFEF9, 0xFEFA},
+	'ا': {0xFEFB, 0xFEFC},
+}
+
+// mirroredChars are the characters replaced by its mirror when written right-to-left
+var mirroredChars = map[rune]rune{
+	'(': ')', ')': '(',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'<': '>', '>': '<',
+	'«': '»', '»': '«',
+}
+
+type bidi struct{}
+
+// NewBidi create a Bidi
+func NewBidi() *bidi {
+	return &bidi{}
+}
+
+// Shape replace Arabic letters by its contextual presentation forms (isolated, final,
+// initial or medial), including the lam-alef ligatures. Others characters are kept
+func (s *bidi) Shape(text string) string {
+	runes := []rune(text)
+	shaped := make([]rune, 0, len(runes))
+
+	for index := 0; index < len(runes); index++ {
+		forms, ok := arabicLetters[runes[index]]
+		if !ok {
+			shaped = append(shaped, runes[index])
+			continue
+		}
+
+		previousJoins := joinsNext(previousLetter(runes, index))
+		nextIndex := nextLetterIndex(runes, index)
+		next := rune(0)
+		if nextIndex < len(runes) {
+			next = runes[nextIndex]
+		}
+
+		if ligature, ok := lamAlefLigatures[next]; ok && runes[index] == arabicLam {
+			if previousJoins {
+				shaped = append(shaped, ligature[finalForm])
+			} else {
+				shaped = append(shaped, ligature[isolatedForm])
+			}
+
+			// Keep the marks between lam and alef, and skip the alef
+			shaped = append(shaped, runes[index+1:nextIndex]...)
+			index = nextIndex
+			continue
+		}
+
+		_, nextJoinable := arabicLetters[next]
+		nextJoins := forms[initialForm] != 0 && (nextJoinable || next == arabicTatweel)
+
+		form := isolatedForm
+		if previousJoins && nextJoins {
+			form = medialForm
+		} else if previousJoins && forms[finalForm] != 0 {
+			form = finalForm
+		} else if nextJoins {
+			form = initialForm
+		}
+
+		shaped = append(shaped, forms[form])
+	}
+
+	return string(shaped)
+}
+
+// GetDirection resolve consts.Auto to the direction of the first strong character
+// of the text, the others directions are returned without changes
+func (s *bidi) GetDirection(text string, direction consts.Direction) consts.Direction {
+	if direction != consts.Auto {
+		return direction
+	}
+
+	for _, char := range text {
+		switch getBidiClass(char) {
+		case bidiL:
+			return consts.LeftToRight
+		case bidiR, bidiAL:
+			return consts.RightToLeft
+		}
+	}
+
+	return consts.LeftToRight
+}
+
+// Reorder convert a line from the logical order, which the text is typed, to the visual
+// order, which the text is written from left to right, following the Unicode
+// bidirectional algorithm without explicit embeddings
+func (s *bidi) Reorder(line string, direction consts.Direction) string {
+	chars, offsets := splitChars(line)
+	if len(chars) == 0 {
+		return line
+	}
+
+	baseLevel := 0
+	if s.GetDirection(line, direction) == consts.RightToLeft {
+		baseLevel = 1
+	}
+
+	classes := make([]bidiClass, len(chars))
+	for index, char := range chars {
+		classes[index] = getBidiClass(char)
+	}
+
+	resolveWeakTypes(classes, baseLevel)
+	resolveNeutralTypes(classes, baseLevel)
+	levels := resolveLevels(classes, baseLevel)
+
+	// Trailing whitespaces stays in the paragraph direction
+	for index := len(chars) - 1; index >= 0 && getBidiClass(chars[index]) == bidiWS; index-- {
+		levels[index] = baseLevel
+	}
+
+	order := reorderLevels(levels)
+
+	reordered := make([]byte, 0, len(line))
+	for _, index := range order {
+		if mirrored, ok := mirroredChars[chars[index]]; ok && levels[index]%2 == 1 {
+			reordered = append(reordered, string(mirrored)...)
+			continue
+		}
+
+		reordered = append(reordered, line[offsets[index]:offsets[index+1]]...)
+	}
+
+	return string(reordered)
+}
+
+// splitChars decode the characters of a line and its byte offsets, each invalid byte,
+// like the ones from cp1252 translated texts, becomes an utf8.RuneError
+func splitChars(line string) ([]rune, []int) {
+	chars := make([]rune, 0, len(line))
+	offsets := make([]int, 0, len(line)+1)
+
+	for index := 0; index < len(line); {
+		char, size := utf8.DecodeRuneInString(line[index:])
+		chars = append(chars, char)
+		offsets = append(offsets, index)
+		index += size
+	}
+
+	return chars, append(offsets, len(line))
+}
+
+func getBidiClass(char rune) bidiClass {
+	switch {
+	case char >= '0' && char <= '9', char >= 0x06F0 && char <= 0x06F9:
+		return bidiEN
+	case char >= 0x0660 && char <= 0x0669, char == 0x066B, char == 0x066C:
+		return bidiAN
+	case char == '+' || char == '-':
+		return bidiES
+	case char == '#' || char == '$' || char == '%' || char == 0x00B0 || char == 0x20AC || char == 0x00A3:
+		return bidiET
+	case char == ',' || char == '.' || char == ':' || char == '/' || char == 0x00A0:
+		return bidiCS
+	case char == ' ' || char == '\t':
+		return bidiWS
+	case unicode.Is(unicode.Mn, char):
+		return bidiNSM
+	case char >= 0x0590 && char <= 0x05FF, char >= 0x07C0 && char <= 0x085F, char >= 0xFB1D && char <= 0xFB4F:
+		return bidiR
+	case char >= 0x0600 && char <= 0x07BF, char >= 0x08A0 && char <= 0x08FF,
+		char >= 0xFB50 && char <= 0xFDFF, char >= 0xFE70 && char <= 0xFEFF:
+		return bidiAL
+	case char == utf8.RuneError, unicode.IsLetter(char), unicode.IsDigit(char):
+		return bidiL
+	}
+
+	return bidiON
+}
+
+// resolveWeakTypes apply the rules W1 to W7 from the Unicode bidirectional algorithm
+func resolveWeakTypes(classes []bidiClass, baseLevel int) {
+	sos := bidiL
+	if baseLevel == 1 {
+		sos = bidiR
+	}
+
+	// W1: non-spacing marks take the type of the previous character
+	for index, class := range classes {
+		if class == bidiNSM {
+			if index == 0 {
+				classes[index] = sos
+			} else {
+				classes[index] = classes[index-1]
+			}
+		}
+	}
+
+	// W2 and W3: european numbers after arabic letters are arabic numbers
+	lastStrong := sos
+	for index, class := range classes {
+		switch class {
+		case bidiL, bidiR, bidiAL:
+			lastStrong = class
+		case bidiEN:
+			if lastStrong == bidiAL {
+				classes[index] = bidiAN
+			}
+		}
+
+		if class == bidiAL {
+			classes[index] = bidiR
+		}
+	}
+
+	// W4: a single separator between two numbers of the same type
+	for index := 1; index < len(classes)-1; index++ {
+		previous, next := classes[index-1], classes[index+1]
+		if classes[index] == bidiES && previous == bidiEN && next == bidiEN {
+			classes[index] = bidiEN
+		} else if classes[index] == bidiCS && previous == next && (previous == bidiEN || previous == bidiAN) {
+			classes[index] = previous
+		}
+	}
+
+	// W5: terminators adjacent to european numbers
+	for index, class := range classes {
+		if class != bidiEN {
+			continue
+		}
+
+		for before := index - 1; before >= 0 && classes[before] == bidiET; before-- {
+			classes[before] = bidiEN
+		}
+
+		for after := index + 1; after < len(classes) && classes[after] == bidiET; after++ {
+			classes[after] = bidiEN
+		}
+	}
+
+	// W6 and W7: remaining separators are neutrals, and european numbers after
+	// left-to-right letters are left-to-right
+	lastStrong = sos
+	for index, class := range classes {
+		switch class {
+		case bidiES, bidiET, bidiCS:
+			classes[index] = bidiON
+		case bidiL, bidiR:
+			lastStrong = class
+		case bidiEN:
+			if lastStrong == bidiL {
+				classes[index] = bidiL
+			}
+		}
+	}
+}
+
+// resolveNeutralTypes apply the rules N1 and N2 from the Unicode bidirectional algorithm
+func resolveNeutralTypes(classes []bidiClass, baseLevel int) {
+	embedding := bidiL
+	if baseLevel == 1 {
+		embedding = bidiR
+	}
+
+	strongDirection := func(class bidiClass) bidiClass {
+		if class == bidiL {
+			return bidiL
+		}
+
+		return bidiR
+	}
+
+	for index := 0; index < len(classes); {
+		if classes[index] != bidiWS && classes[index] != bidiON {
+			index++
+			continue
+		}
+
+		end := index
+		for end < len(classes) && (classes[end] == bidiWS || classes[end] == bidiON) {
+			end++
+		}
+
+		before, after := embedding, embedding
+		if index > 0 {
+			before = strongDirection(classes[index-1])
+		}
+
+		if end < len(classes) {
+			after = strongDirection(classes[end])
+		}
+
+		resolved := embedding
+		if before == after {
+			resolved = before
+		}
+
+		for neutral := index; neutral < end; neutral++ {
+			classes[neutral] = resolved
+		}
+
+		index = end
+	}
+}
+
+// resolveLevels apply the rules I1 and I2 from the Unicode bidirectional algorithm
+func resolveLevels(classes []bidiClass, baseLevel int) []int {
+	levels := make([]int, len(classes))
+
+	for index, class := range classes {
+		levels[index] = baseLevel
+
+		if baseLevel == 0 {
+			if class == bidiR {
+				levels[index]++
+			} else if class == bidiAN || class == bidiEN {
+				levels[index] += 2
+			}
+		} else if class == bidiL || class == bidiEN || class == bidiAN {
+			levels[index]++
+		}
+	}
+
+	return levels
+}
+
+// reorderLevels apply the rule L2 from the Unicode bidirectional algorithm, reversing
+// from the highest level to the lowest odd level the sequences at that level or higher
+func reorderLevels(levels []int) []int {
+	order := make([]int, len(levels))
+	highest, lowestOdd := 0, -1
+
+	for index, level := range levels {
+		order[index] = index
+
+		if level > highest {
+			highest = level
+		}
+
+		if level%2 == 1 && (lowestOdd == -1 || level < lowestOdd) {
+			lowestOdd = level
+		}
+	}
+
+	if lowestOdd == -1 {
+		return order
+	}
+
+	for level := highest; level >= lowestOdd; level-- {
+		for index := 0; index < len(order); {
+			if levels[order[index]] < level {
+				index++
+				continue
+			}
+
+			end := index
+			for end < len(order) && levels[order[end]] >= level {
+				end++
+			}
+
+			for left, right := index, end-1; left < right; left, right = left+1, right-1 {
+				order[left], order[right] = order[right], order[left]
+			}
+
+			index = end
+		}
+	}
+
+	return order
+}
+
+// previousLetter return the previous character which isn't a mark
+func previousLetter(runes []rune, index int) rune {
+	for index--; index >= 0; index-- {
+		if !unicode.Is(unicode.Mn, runes[index]) {
+			return runes[index]
+		}
+	}
+
+	return 0
+}
+
+// nextLetterIndex return the position of the next character which isn't a mark
+func nextLetterIndex(runes []rune, index int) int {
+	for index++; index < len(runes); index++ {
+		if !unicode.Is(unicode.Mn, runes[index]) {
+			return index
+		}
+	}
+
+	return index
+}
+
+// joinsNext return if a character connects to the following letter
+func joinsNext(char rune) bool {
+	if char == arabicTatweel {
+		return true
+	}
+
+	forms, ok := arabicLetters[char]
+
+	return ok && forms[initialForm] != 0
+}
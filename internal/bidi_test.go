@@ -0,0 +1,99 @@
+package internal_test
+
+import (
+	"fmt"
+	"github.com/muhammadmuhlas/just_pdf/internal"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewBidi(t *testing.T) {
+	bidi := internal.NewBidi()
+
+	assert.NotNil(t, bidi)
+	assert.Equal(t, fmt.Sprintf("%T", bidi), "*internal.bidi")
+}
+
+func TestBidi_Shape(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"Latin text", "Hello", "Hello"},
+		{"Initial, medial and final forms", "محمد", "ﻣﺤﻤﺪ"},
+		{"Letter which doesn't join the next", "باب", "ﺑﺎﺏ"},
+		{"Lam-alef ligature", "سلام", "ﺳﻼﻡ"},
+		{"Isolated lam-alef ligature", "لا", "ﻻ"},
+		{"Words are shaped separately", "بب بب", "ﺑﺐ ﺑﺐ"},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		bidi := internal.NewBidi()
+
+		// Act
+		shaped := bidi.Shape(c.text)
+
+		// Assert
+		assert.Equal(t, c.expected, shaped, c.name)
+	}
+}
+
+func TestBidi_GetDirection(t *testing.T) {
+	cases := []struct {
+		name      string
+		text      string
+		direction consts.Direction
+		expected  consts.Direction
+	}{
+		{"Defined direction", "שלום", consts.LeftToRight, consts.LeftToRight},
+		{"Auto with latin text", "123 Hello שלום", consts.Auto, consts.LeftToRight},
+		{"Auto with hebrew text", "123 שלום Hello", consts.Auto, consts.RightToLeft},
+		{"Auto with arabic text", "مرحبا", consts.Auto, consts.RightToLeft},
+		{"Auto without letters", "123", consts.Auto, consts.LeftToRight},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		bidi := internal.NewBidi()
+
+		// Act
+		direction := bidi.GetDirection(c.text, c.direction)
+
+		// Assert
+		assert.Equal(t, c.expected, direction, c.name)
+	}
+}
+
+func TestBidi_Reorder(t *testing.T) {
+	cases := []struct {
+		name      string
+		line      string
+		direction consts.Direction
+		expected  string
+	}{
+		{"Latin left-to-right", "abc def", consts.LeftToRight, "abc def"},
+		{"Latin right-to-left", "abc def", consts.RightToLeft, "abc def"},
+		{"Hebrew right-to-left", "שלום עולם", consts.RightToLeft, "םלוע םולש"},
+		{"Hebrew inside left-to-right", "abc אבג דה def", consts.LeftToRight, "abc הד גבא def"},
+		{"Numbers inside right-to-left", "אבג 123", consts.RightToLeft, "123 גבא"},
+		{"Decimal inside right-to-left", "אבג 1.5", consts.RightToLeft, "1.5 גבא"},
+		{"Latin inside right-to-left", "אבג abc def", consts.RightToLeft, "abc def גבא"},
+		{"Mirrored brackets", "(אבג)", consts.RightToLeft, "(גבא)"},
+		{"Auto direction", "אבג abc", consts.Auto, "abc גבא"},
+		{"Not UTF-8 bytes", "a\xe9b", consts.RightToLeft, "a\xe9b"},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		bidi := internal.NewBidi()
+
+		// Act
+		reordered := bidi.Reorder(c.line, c.direction)
+
+		// Assert
+		assert.Equal(t, c.expected, reordered, c.name)
+	}
+}
@@ -32,15 +32,19 @@ func (s *code) AddQr(code string, marginTop float64, indexCol float64, qtdCols f
 	key := barcode.RegisterQR(s.pdf, code, qr.H, qr.Unicode)
 
 	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
+	width, height := actualWidthPerCol, actualWidthPerCol
+	if prop.Rotation != 0 {
+		width, height = s.math.GetRotatedBoundingBox(width, height, prop.Rotation)
+	}
 
 	var x, y, w, h float64
 	if prop.Center {
-		x, y, w, h = s.math.GetRectCenterColProperties(actualWidthPerCol, actualWidthPerCol, qtdCols, colHeight, indexCol, prop.Percent)
+		x, y, w, h = s.math.GetRectCenterColProperties(width, height, qtdCols, colHeight, indexCol, prop.Percent)
 	} else {
-		x, y, w, h = s.math.GetRectNonCenterColProperties(actualWidthPerCol, actualWidthPerCol, qtdCols, colHeight, indexCol, prop)
+		x, y, w, h = s.math.GetRectNonCenterColProperties(width, height, qtdCols, colHeight, indexCol, prop)
 	}
 
-	barcode.Barcode(s.pdf, key, x, y+marginTop, w, h, false)
+	s.addCode(key, actualWidthPerCol, actualWidthPerCol, width, prop.Rotation, x, y+marginTop, w, h)
 }
 
 // AddBar create a Barcode inside a cell
@@ -53,14 +57,36 @@ func (s *code) AddBar(code string, marginTop float64, indexCol float64, qtdCols
 
 	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
 	heightPercentFromWidth := prop.Proportion.Height / prop.Proportion.Width
+	width, height := actualWidthPerCol, actualWidthPerCol*heightPercentFromWidth
+	if prop.Rotation != 0 {
+		width, height = s.math.GetRotatedBoundingBox(width, height, prop.Rotation)
+	}
+
 	var x, y, w, h float64
 	if prop.Center {
-		x, y, w, h = s.math.GetRectCenterColProperties(actualWidthPerCol, actualWidthPerCol*heightPercentFromWidth, qtdCols, colHeight, indexCol, prop.Percent)
+		x, y, w, h = s.math.GetRectCenterColProperties(width, height, qtdCols, colHeight, indexCol, prop.Percent)
 	} else {
-		rectProps := props.Rect{Left: prop.Left, Top: prop.Top, Center: prop.Center, Percent: prop.Percent}
-		x, y, w, h = s.math.GetRectNonCenterColProperties(actualWidthPerCol, actualWidthPerCol*heightPercentFromWidth, qtdCols, colHeight, indexCol, rectProps)
+		rectProps := props.Rect{Left: prop.Left, Top: prop.Top, Center: prop.Center, Percent: prop.Percent, Align: prop.Align, VerticalAlign: prop.VerticalAlign}
+		x, y, w, h = s.math.GetRectNonCenterColProperties(width, height, qtdCols, colHeight, indexCol, rectProps)
 	}
 
-	barcode.Barcode(s.pdf, barcode.Register(bcode), x, y+marginTop, w, h, false)
+	s.addCode(barcode.Register(bcode), actualWidthPerCol, actualWidthPerCol*heightPercentFromWidth, width, prop.Rotation, x, y+marginTop, w, h)
 	return
 }
+
+// addCode draw a registered code inside the rectangle (x, y, w, h), when rotated the
+// rectangle is the bounding box and the code keeps the scale between codeWidth and boxWidth
+func (s *code) addCode(key string, codeWidth, codeHeight, boxWidth, angle, x, y, w, h float64) {
+	if angle == 0 {
+		barcode.Barcode(s.pdf, key, x, y, w, h, false)
+		return
+	}
+
+	scale := w / boxWidth
+	width, height := codeWidth*scale, codeHeight*scale
+	centerX, centerY := x+w/2.0, y+h/2.0
+
+	rotate(s.pdf, angle, centerX, centerY, func() {
+		barcode.Barcode(s.pdf, key, centerX-width/2.0, centerY-height/2.0, width, height, false)
+	})
+}
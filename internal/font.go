@@ -3,6 +3,7 @@ package internal
 import (
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/jung-kurt/gofpdf"
+	"io/ioutil"
 )
 
 // Font is the abstraction which deals of how to set font configurations
@@ -16,14 +17,22 @@ type Font interface {
 	GetSize() float64
 	GetFont() (consts.Family, consts.Style, float64)
 	GetScaleFactor() (scaleFactor float64)
+	AddUTF8Font(family consts.Family, style consts.Style, file string)
+	IsUTF8(family consts.Family) bool
+}
+
+// utf8FontPdf is implemented by gofpdf.Fpdf, but it isn't part of gofpdf.Pdf
+type utf8FontPdf interface {
+	AddUTF8FontFromBytes(familyStr, styleStr string, utf8Bytes []byte)
 }
 
 type font struct {
-	pdf         gofpdf.Pdf
-	size        float64
-	family      consts.Family
-	style       consts.Style
-	scaleFactor float64
+	pdf          gofpdf.Pdf
+	size         float64
+	family       consts.Family
+	style        consts.Style
+	scaleFactor  float64
+	utf8Families map[consts.Family]bool
 }
 
 // NewFont create a Font
@@ -34,6 +43,7 @@ func NewFont(pdf gofpdf.Pdf, size float64, family consts.Family, style consts.St
 		family,
 		style,
 		72.0 / 25.4, // Value defined inside gofpdf constructor
+		make(map[consts.Family]bool),
 	}
 }
 
@@ -90,3 +100,27 @@ func (s *font) SetFont(family consts.Family, style consts.Style, size float64) {
 func (s *font) GetScaleFactor() (scaleFactor float64) {
 	return s.scaleFactor
 }
+
+// AddUTF8Font register a TrueType font file with UTF-8 encoding, which allows texts
+// outside cp1252 like Arabic and Hebrew. Each style used must be registered
+func (s *font) AddUTF8Font(family consts.Family, style consts.Style, file string) {
+	utf8Pdf, ok := s.pdf.(utf8FontPdf)
+	if !ok {
+		return
+	}
+
+	// gofpdf joins the file to its font directory, which breaks absolute paths
+	utf8Bytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		s.pdf.SetError(err)
+		return
+	}
+
+	utf8Pdf.AddUTF8FontFromBytes(string(family), string(style), utf8Bytes)
+	s.utf8Families[family] = true
+}
+
+// IsUTF8 return if a Font family was registered with UTF-8 encoding
+func (s *font) IsUTF8(family consts.Family) bool {
+	return s.utf8Families[family]
+}
@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"fmt"
+	"github.com/jung-kurt/gofpdf"
 	"github.com/muhammadmuhlas/just_pdf/internal"
 	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
@@ -366,3 +367,28 @@ func TestFont_GetScaleFactor(t *testing.T) {
 	// Assert
 	assert.InDelta(t, scalarFactor, 2.83, 0.1)
 }
+
+func TestFont_AddUTF8Font(t *testing.T) {
+	// Arrange
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	font := internal.NewFont(pdf, 10, consts.Arial, consts.Normal)
+
+	// Act
+	font.AddUTF8Font("dejavu", consts.Normal, "not_found.ttf")
+
+	// Assert
+	assert.False(t, font.IsUTF8("dejavu"))
+	assert.False(t, font.IsUTF8(consts.Arial))
+	assert.NotNil(t, pdf.Error())
+}
+
+func TestFont_AddUTF8Font_WhenPdfDoesntSupport(t *testing.T) {
+	// Arrange
+	font := internal.NewFont(&mocks.Pdf{}, 10, consts.Arial, consts.Normal)
+
+	// Act
+	font.AddUTF8Font("dejavu", consts.Normal, "dejavu.ttf")
+
+	// Assert
+	assert.False(t, font.IsUTF8("dejavu"))
+}
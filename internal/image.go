@@ -68,11 +68,30 @@ func (s *image) AddFromBase64(b64 string, marginTop float64, indexCol float64, q
 }
 
 func (s *image) addImageToPdf(imageLabel string, info *gofpdf.ImageInfoType, marginTop, qtdCols, colHeight, indexCol float64, prop props.Rect) {
+	width, height := info.Width(), info.Height()
+	if prop.Rotation != 0 {
+		width, height = s.math.GetRotatedBoundingBox(width, height, prop.Rotation)
+	}
+
 	var x, y, w, h float64
 	if prop.Center {
-		x, y, w, h = s.math.GetRectCenterColProperties(info.Width(), info.Height(), qtdCols, colHeight, indexCol, prop.Percent)
+		x, y, w, h = s.math.GetRectCenterColProperties(width, height, qtdCols, colHeight, indexCol, prop.Percent)
 	} else {
-		x, y, w, h = s.math.GetRectNonCenterColProperties(info.Width(), info.Height(), qtdCols, colHeight, indexCol, prop)
+		x, y, w, h = s.math.GetRectNonCenterColProperties(width, height, qtdCols, colHeight, indexCol, prop)
+	}
+
+	if prop.Rotation == 0 {
+		s.pdf.Image(imageLabel, x, y+marginTop, w, h, false, "", 0, "")
+		return
 	}
-	s.pdf.Image(imageLabel, x, y+marginTop, w, h, false, "", 0, "")
+
+	// The bounding box was fitted inside the cell, the image keeps
+	// the same scale and is drawn rotated around the box center
+	scale := w / width
+	imageWidth, imageHeight := info.Width()*scale, info.Height()*scale
+	centerX, centerY := x+w/2.0, y+marginTop+h/2.0
+
+	rotate(s.pdf, prop.Rotation, centerX, centerY, func() {
+		s.pdf.Image(imageLabel, centerX-imageWidth/2.0, centerY-imageHeight/2.0, imageWidth, imageHeight, false, "", 0, "")
+	})
 }
@@ -176,6 +176,33 @@ func TestImage_AddFromFile(t *testing.T) {
 	}
 }
 
+func TestImage_AddFromFile_WhenRotated(t *testing.T) {
+	// Arrange
+	pdf := &mocks.Pdf{}
+	pdf.On("RegisterImageOptions", mock.Anything, mock.Anything).Return(widthGreaterThanHeightImageInfo())
+	pdf.On("Image", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("TransformBegin")
+	pdf.On("TransformRotate", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("TransformEnd")
+
+	math := &mocks.Math{}
+	math.On("GetRotatedBoundingBox", mock.Anything, mock.Anything, 90.0).Return(119.0, 88.0)
+	math.On("GetRectCenterColProperties", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(100.0, 20.0, 40.0, 30.0)
+
+	image := internal.NewImage(pdf, math)
+
+	// Act
+	err := image.AddFromFile("AnyPath", 10.0, 1.0, 4.0, 5.0, props.Rect{Center: true, Percent: 100, Rotation: 90})
+
+	// Assert
+	assert.Nil(t, err)
+	math.AssertCalled(t, "GetRectCenterColProperties", 119, 88, 4, 5, 1, 100)
+	pdf.AssertNumberOfCalls(t, "TransformBegin", 1)
+	pdf.AssertCalled(t, "TransformRotate", 90.0, 120.0, 45.0)
+	pdf.AssertCalled(t, "Image", "", 105, 24, 29, 40)
+	pdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+}
+
 func TestImage_AddFromBase64(t *testing.T) {
 	cases := []struct {
 		name            string
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"github.com/muhammadmuhlas/just_pdf/pkg/props"
+	"strconv"
+	"strings"
+)
+
+// List is the abstraction to create a bullet or numbered list, with nested items
+type List interface {
+	Create(items []props.ListItem, prop ...props.List)
+	BindGrid(part JustPdfGridPart)
+}
+
+// romanNumerals are the values and symbols used to write roman numbers, from the greatest
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+type list struct {
+	pdf  JustPdfGridPart
+	text Text
+	font Font
+}
+
+// NewList create a List
+func NewList(text Text, font Font) *list {
+	return &list{
+		text: text,
+		font: font,
+	}
+}
+
+// BindGrid bind the grid system to List
+func (s *list) BindGrid(pdf JustPdfGridPart) {
+	s.pdf = pdf
+}
+
+// Create add a Row to each item, so the page can break between the items.
+// Nested items are added below its parent
+func (s *list) Create(items []props.ListItem, prop ...props.List) {
+	listProp := props.List{}
+
+	if len(prop) > 0 {
+		listProp = prop[0]
+	}
+
+	listProp.MakeValid()
+
+	s.addItems(items, listProp, 0)
+}
+
+func (s *list) addItems(items []props.ListItem, listProp props.List, level int) {
+	for index, item := range items {
+		s.addItem(item.Text, s.getMarker(listProp, index+1), listProp, level)
+		s.addItems(item.Items, listProp, level+1)
+	}
+}
+
+// addItem write the marker and the text with a hanging indent, each one
+// in its part of the page after the indent of the level
+func (s *list) addItem(text, marker string, listProp props.List, level int) {
+	pageWidth, _ := s.pdf.GetPageSize()
+	left, _, right, _ := s.pdf.GetPageMargins()
+	width := pageWidth - left - right
+	markerX := float64(level) * listProp.Indent
+	textX := markerX + listProp.MarkerWidth
+
+	textProp := listProp.Font.ToTextProp(consts.Left, 0.0, false, 0.0)
+	textProp.Color = listProp.Color
+
+	markerProp := textProp
+	if listProp.Type == consts.Bullet {
+		markerProp.Family = listProp.BulletFamily
+	}
+
+	// The lines are measured like in Text, with the space of the raised and lowered parts
+	textHeight := 0.0
+	inCell(s.pdf, textX, width-textX, func() {
+		textHeight = s.text.GetLinesHeight(text, textProp, 1)
+	})
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+	itemHeight := textHeight + listProp.ItemSpacing
+
+	s.pdf.Row(itemHeight, func() {
+		s.pdf.Col(func() {
+			baseline := s.pdf.GetCurrentOffset() + fontHeight
+
+			inCell(s.pdf, markerX, width-markerX, func() {
+				s.text.Add(marker, markerProp, baseline, 0, 1)
+			})
+
+			inCell(s.pdf, textX, width-textX, func() {
+				s.text.Add(text, textProp, baseline, 0, 1)
+			})
+		})
+	})
+}
+
+// getMarker return the marker of the item in a position, starting at 1
+func (s *list) getMarker(listProp props.List, position int) string {
+	switch listProp.Type {
+	case consts.Decimal:
+		return strconv.Itoa(position) + "."
+	case consts.LowerAlpha:
+		return strings.ToLower(s.toAlpha(position)) + "."
+	case consts.UpperAlpha:
+		return s.toAlpha(position) + "."
+	case consts.LowerRoman:
+		return strings.ToLower(s.toRoman(position)) + "."
+	case consts.UpperRoman:
+		return s.toRoman(position) + "."
+	}
+
+	return listProp.Bullet
+}
+
+// toAlpha convert a position to letters: A, B, ..., Z, AA, AB...
+func (s *list) toAlpha(position int) string {
+	alpha := ""
+
+	for position > 0 {
+		position--
+		alpha = string(rune('A'+position%26)) + alpha
+		position /= 26
+	}
+
+	return alpha
+}
+
+// toRoman convert a position to a roman number
+func (s *list) toRoman(position int) string {
+	roman := ""
+
+	for _, numeral := range romanNumerals {
+		for position >= numeral.value {
+			roman += numeral.symbol
+			position -= numeral.value
+		}
+	}
+
+	return roman
+}
@@ -0,0 +1,182 @@
+package internal_test
+
+import (
+	"fmt"
+	"github.com/muhammadmuhlas/just_pdf/internal"
+	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"github.com/muhammadmuhlas/just_pdf/pkg/props"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestNewList(t *testing.T) {
+	// Act
+	list := internal.NewList(nil, nil)
+
+	// Assert
+	assert.NotNil(t, list)
+	assert.Equal(t, fmt.Sprintf("%T", list), "*internal.list")
+}
+
+func TestList_Create_WhenItemsIsEmpty(t *testing.T) {
+	// Arrange
+	justPdfGrid := baseListGridTest()
+	sut := internal.NewList(&mocks.Text{}, &mocks.Font{})
+	sut.BindGrid(justPdfGrid)
+
+	// Act
+	sut.Create(nil)
+
+	// Assert
+	justPdfGrid.AssertNotCalled(t, "Row", mock.Anything, mock.Anything)
+}
+
+func TestList_Create_Markers(t *testing.T) {
+	cases := []struct {
+		name     string
+		listType consts.ListType
+		expected []string
+	}{
+		{"Bullet", consts.Bullet, []string{"•", "•", "•"}},
+		{"Decimal", consts.Decimal, []string{"1.", "2.", "3."}},
+		{"Lower alpha", consts.LowerAlpha, []string{"a.", "b.", "c."}},
+		{"Upper alpha", consts.UpperAlpha, []string{"A.", "B.", "C."}},
+		{"Lower roman", consts.LowerRoman, []string{"i.", "ii.", "iii."}},
+		{"Upper roman", consts.UpperRoman, []string{"I.", "II.", "III."}},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		text := baseListTextTest()
+		justPdfGrid := baseListGridTest()
+
+		sut := internal.NewList(text, baseListFontTest())
+		sut.BindGrid(justPdfGrid)
+
+		items := []props.ListItem{{Text: "first"}, {Text: "second"}, {Text: "third"}}
+
+		// Act
+		sut.Create(items, props.List{Type: c.listType})
+
+		// Assert
+		justPdfGrid.AssertNumberOfCalls(t, "Row", 3)
+		for i, marker := range c.expected {
+			text.AssertCalled(t, "Add", marker, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			text.AssertCalled(t, "Add", items[i].Text, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	}
+}
+
+func TestList_Create_WhenAlphaIsGreaterThanAlphabet(t *testing.T) {
+	// Arrange
+	text := baseListTextTest()
+	justPdfGrid := baseListGridTest()
+
+	sut := internal.NewList(text, baseListFontTest())
+	sut.BindGrid(justPdfGrid)
+
+	items := make([]props.ListItem, 28)
+
+	// Act
+	sut.Create(items, props.List{Type: consts.LowerAlpha})
+
+	// Assert
+	text.AssertCalled(t, "Add", "z.", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "aa.", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "ab.", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestList_Create_WhenNested(t *testing.T) {
+	// Arrange
+	text := baseListTextTest()
+	justPdfGrid := baseListGridTest()
+
+	sut := internal.NewList(text, baseListFontTest())
+	sut.BindGrid(justPdfGrid)
+
+	items := []props.ListItem{
+		{Text: "first", Items: []props.ListItem{{Text: "nested"}}},
+		{Text: "second"},
+	}
+
+	// Act
+	sut.Create(items, props.List{Type: consts.Decimal, Indent: 5.0, MarkerWidth: 6.0})
+
+	// Assert
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 3)
+	text.AssertNumberOfCalls(t, "Add", 6)
+	text.AssertCalled(t, "Add", "2.", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Markers of the nested item are moved by the indent, its text by the indent and the marker width
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 15.0, 10.0)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 21.0, 10.0)
+}
+
+func TestList_Create_WhenTextWraps(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(33.5)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	justPdfGrid := baseListGridTest()
+
+	sut := internal.NewList(text, baseListFontTest())
+	sut.BindGrid(justPdfGrid)
+
+	// Act
+	sut.Create([]props.ListItem{{Text: "long text"}}, props.List{Font: props.Font{Size: 10}, ItemSpacing: 2.0})
+
+	// Assert
+	// The height of the lines, with the space of its raised parts, and the item spacing
+	justPdfGrid.AssertCalled(t, "Row", 35.5, mock.Anything)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 16.0, 10.0)
+}
+
+func TestList_Create_WhenSpacesAreNegative(t *testing.T) {
+	// Arrange
+	text := baseListTextTest()
+	justPdfGrid := baseListGridTest()
+
+	sut := internal.NewList(text, baseListFontTest())
+	sut.BindGrid(justPdfGrid)
+
+	items := []props.ListItem{{Text: "first", Items: []props.ListItem{{Text: "nested"}}}}
+
+	// Act
+	sut.Create(items, props.List{Font: props.Font{Size: 10}, Indent: -1.0, MarkerWidth: -1.0, ItemSpacing: -1.0})
+
+	// Assert
+	justPdfGrid.AssertCalled(t, "Row", 10.0, mock.Anything)
+	justPdfGrid.AssertNotCalled(t, "SetLRMargins", 15.0, 10.0)
+	justPdfGrid.AssertNotCalled(t, "SetLRMargins", 16.0, 10.0)
+}
+
+func baseListTextTest() *mocks.Text {
+	text := &mocks.Text{}
+	text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(10.0)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	return text
+}
+
+func baseListFontTest() *mocks.Font {
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(1.0)
+	return font
+}
+
+func baseListGridTest() *mocks.JustPdf {
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("GetPageSize").Return(100.0, 100.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	return justPdfGrid
+}
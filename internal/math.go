@@ -1,6 +1,9 @@
 package internal
 
 import (
+	gomath "math"
+
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
 	"github.com/jung-kurt/gofpdf"
 )
@@ -11,6 +14,8 @@ type Math interface {
 	GetRectCenterColProperties(imageWidth float64, imageHeight float64, qtdCols float64, colHeight float64, indexCol float64, percent float64) (x float64, y float64, w float64, h float64)
 	GetRectNonCenterColProperties(imageWidth float64, imageHeight float64, qtdCols float64, colHeight float64, indexCol float64, prop props.Rect) (x float64, y float64, w float64, h float64)
 	GetCenterCorrection(outerSize, innerSize float64) float64
+	GetAlignCorrection(align consts.Align, outerSize, innerSize float64) float64
+	GetRotatedBoundingBox(width, height, angle float64) (boxWidth float64, boxHeight float64)
 }
 
 type math struct {
@@ -95,11 +100,41 @@ func (s *math) GetRectNonCenterColProperties(imageWidth float64, imageHeight flo
 		h = newImageHeight
 	}
 
+	// Left and Top are paddings, the align is applied to the space left after them
+	x += s.GetAlignCorrection(prop.Align, widthPerCol-prop.Left, w)
+	y += s.GetAlignCorrection(prop.VerticalAlign, colHeight-prop.Top, h)
+
 	return
 }
 
+// GetAlignCorrection return the correction of space in X or Y to
+// align a line inside another line, Left/Top aligns don't need correction
+func (s *math) GetAlignCorrection(align consts.Align, outerSize, innerSize float64) float64 {
+	switch align {
+	case consts.Center, consts.Middle:
+		return s.GetCenterCorrection(outerSize, innerSize)
+	case consts.Right, consts.Bottom:
+		return outerSize - innerSize
+	default:
+		return 0
+	}
+}
+
 // GetCenterCorrection return the correction of space in X or Y to
 // centralize a line in relation with another line
 func (s *math) GetCenterCorrection(outerSize, innerSize float64) float64 {
 	return (outerSize - innerSize) / 2.0
 }
+
+// GetRotatedBoundingBox return the size of the smallest box, aligned with the page,
+// which contains a rectangle rotated by angle degrees
+func (s *math) GetRotatedBoundingBox(width, height, angle float64) (boxWidth float64, boxHeight float64) {
+	radians := angle * gomath.Pi / 180.0
+	sin := gomath.Abs(gomath.Sin(radians))
+	cos := gomath.Abs(gomath.Cos(radians))
+
+	boxWidth = width*cos + height*sin
+	boxHeight = width*sin + height*cos
+
+	return
+}
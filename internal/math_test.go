@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/muhammadmuhlas/just_pdf/internal"
 	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -325,6 +326,86 @@ func TestMath_GetRectNonCenterColProperties(t *testing.T) {
 				assert.InDelta(t, h, 24.1, 0.1)
 			},
 		},
+		{
+			"When Image is aligned right and bottom",
+			200,
+			300,
+			props.Rect{
+				Percent:       100,
+				Align:         consts.Right,
+				VerticalAlign: consts.Bottom,
+			},
+			func() *mocks.Pdf {
+				pdf := &mocks.Pdf{}
+				pdf.On("GetMargins").Return(15.0, 12.0, 17.0, 10.0)
+				pdf.On("GetPageSize").Return(211.0, 233.0)
+				return pdf
+			},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "GetMargins", 1)
+				pdf.AssertNumberOfCalls(t, "GetPageSize", 1)
+			},
+			func(t *testing.T, x, y, w, h float64) {
+				assert.InDelta(t, x, 105.7, 0.1)
+				assert.InDelta(t, y, 12, 0.1)
+				assert.InDelta(t, w, 16.6, 0.1)
+				assert.InDelta(t, h, 25.0, 0.1)
+			},
+		},
+		{
+			"When Image is aligned right and bottom with left and top",
+			200,
+			300,
+			props.Rect{
+				Percent:       100,
+				Left:          5,
+				Top:           3,
+				Align:         consts.Right,
+				VerticalAlign: consts.Bottom,
+			},
+			func() *mocks.Pdf {
+				pdf := &mocks.Pdf{}
+				pdf.On("GetMargins").Return(15.0, 12.0, 17.0, 10.0)
+				pdf.On("GetPageSize").Return(211.0, 233.0)
+				return pdf
+			},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "GetMargins", 1)
+				pdf.AssertNumberOfCalls(t, "GetPageSize", 1)
+			},
+			func(t *testing.T, x, y, w, h float64) {
+				assert.InDelta(t, x, 105.7, 0.1)
+				assert.InDelta(t, y, 12, 0.1)
+				assert.InDelta(t, w, 16.6, 0.1)
+				assert.InDelta(t, h, 25.0, 0.1)
+			},
+		},
+		{
+			"When Image is aligned center and middle",
+			300,
+			200,
+			props.Rect{
+				Percent:       100,
+				Align:         consts.Center,
+				VerticalAlign: consts.Middle,
+			},
+			func() *mocks.Pdf {
+				pdf := &mocks.Pdf{}
+				pdf.On("GetMargins").Return(12.0, 11.0, 13.0, 15.0)
+				pdf.On("GetPageSize").Return(201.0, 301.0)
+				return pdf
+			},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "GetMargins", 1)
+				pdf.AssertNumberOfCalls(t, "GetPageSize", 1)
+			},
+			func(t *testing.T, x, y, w, h float64) {
+				assert.InDelta(t, x, 82.4, 0.1)
+				assert.InDelta(t, y, 11.8, 0.1)
+				assert.InDelta(t, w, 35.2, 0.1)
+				assert.InDelta(t, h, 23.4, 0.1)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -353,3 +434,59 @@ func TestMath_GetCenterCorrection(t *testing.T) {
 	// Assert
 	assert.Equal(t, correction, 2.5)
 }
+
+func TestMath_GetAlignCorrection(t *testing.T) {
+	cases := []struct {
+		name       string
+		align      consts.Align
+		correction float64
+	}{
+		{"When align is left", consts.Left, 0.0},
+		{"When align is top", consts.Top, 0.0},
+		{"When align is not defined", "", 0.0},
+		{"When align is center", consts.Center, 2.5},
+		{"When align is middle", consts.Middle, 2.5},
+		{"When align is right", consts.Right, 5.0},
+		{"When align is bottom", consts.Bottom, 5.0},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		pdf := &mocks.Pdf{}
+		math := internal.NewMath(pdf)
+
+		// Act
+		correction := math.GetAlignCorrection(c.align, 10, 5)
+
+		// Assert
+		assert.Equal(t, correction, c.correction, c.name)
+	}
+}
+
+func TestMath_GetRotatedBoundingBox(t *testing.T) {
+	cases := []struct {
+		name   string
+		angle  float64
+		width  float64
+		height float64
+	}{
+		{"When not rotated", 0, 10, 5},
+		{"When rotated 90 degrees", 90, 5, 10},
+		{"When rotated -90 degrees", -90, 5, 10},
+		{"When rotated 180 degrees", 180, 10, 5},
+		{"When rotated 45 degrees", 45, 10.6, 10.6},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		pdf := &mocks.Pdf{}
+		math := internal.NewMath(pdf)
+
+		// Act
+		width, height := math.GetRotatedBoundingBox(10, 5, c.angle)
+
+		// Assert
+		assert.InDelta(t, width, c.width, 0.1, c.name)
+		assert.InDelta(t, height, c.height, 0.1, c.name)
+	}
+}
@@ -11,6 +11,11 @@ type Font struct {
 	mock.Mock
 }
 
+// AddUTF8Font provides a mock function with given fields: family, style, file
+func (_m *Font) AddUTF8Font(family consts.Family, style consts.Style, file string) {
+	_m.Called(family, style, file)
+}
+
 // GetFamily provides a mock function with given fields:
 func (_m *Font) GetFamily() consts.Family {
 	ret := _m.Called()
@@ -114,3 +119,17 @@ func (_m *Font) SetSize(size float64) {
 func (_m *Font) SetStyle(style consts.Style) {
 	_m.Called(style)
 }
+
+// IsUTF8 provides a mock function with given fields: family
+func (_m *Font) IsUTF8(family consts.Family) bool {
+	ret := _m.Called(family)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(consts.Family) bool); ok {
+		r0 = rf(family)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
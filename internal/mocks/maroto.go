@@ -5,6 +5,7 @@ package mocks
 import bytes "bytes"
 import color "github.com/muhammadmuhlas/just_pdf/pkg/color"
 import consts "github.com/muhammadmuhlas/just_pdf/pkg/consts"
+import io "io"
 import mock "github.com/stretchr/testify/mock"
 
 import props "github.com/muhammadmuhlas/just_pdf/pkg/props"
@@ -14,8 +15,9 @@ type JustPdf struct {
 	mock.Mock
 }
 
+// SetLRMargins provides a mock function with given fields: lft, right
 func (_m *JustPdf) SetLRMargins(lft, right float64) {
-	_m.SetLRMargins(lft, right)
+	_m.Called(lft, right)
 }
 
 // Barcode provides a mock function with given fields: code, prop
@@ -75,6 +77,33 @@ func (_m *JustPdf) ColSpaces(qtd int) {
 	_m.Called(qtd)
 }
 
+// KeepWithNext provides a mock function with given fields: height, closure
+func (_m *JustPdf) KeepWithNext(height float64, closure func()) {
+	_m.Called(height, closure)
+}
+
+// AddPage provides a mock function with given fields:
+func (_m *JustPdf) AddPage() {
+	_m.Called()
+}
+
+// DrawLine provides a mock function with given fields: x1, y1, x2, y2, prop
+func (_m *JustPdf) DrawLine(x1 float64, y1 float64, x2 float64, y2 float64, prop ...props.Line) {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, x1, y1, x2, y2)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// FillRect provides a mock function with given fields: x, y, width, height, _a4
+func (_m *JustPdf) FillRect(x float64, y float64, width float64, height float64, _a4 color.Color) {
+	_m.Called(x, y, width, height, _a4)
+}
+
 // FileImage provides a mock function with given fields: filePathName, prop
 func (_m *JustPdf) FileImage(filePathName string, prop ...props.Rect) error {
 	_va := make([]interface{}, len(prop))
@@ -138,6 +167,21 @@ func (_m *JustPdf) GetCurrentPage() int {
 	return r0
 }
 
+// AddUTF8Font provides a mock function with given fields: family, style, filePathName
+func (_m *JustPdf) AddUTF8Font(family consts.Family, style consts.Style, filePathName string) {
+	_m.Called(family, style, filePathName)
+}
+
+// Anchor provides a mock function with given fields: name
+func (_m *JustPdf) Anchor(name string) {
+	_m.Called(name)
+}
+
+// Link provides a mock function with given fields: link
+func (_m *JustPdf) Link(link props.Link) {
+	_m.Called(link)
+}
+
 // GetPageMargins provides a mock function with given fields:
 func (_m *JustPdf) GetPageMargins() (float64, float64, float64, float64) {
 	ret := _m.Called()
@@ -256,6 +300,16 @@ func (_m *JustPdf) RegisterHeader(closure func()) {
 	_m.Called(closure)
 }
 
+// RegisterRepeatedFooter provides a mock function with given fields: closure
+func (_m *JustPdf) RegisterRepeatedFooter(closure func()) {
+	_m.Called(closure)
+}
+
+// RegisterRepeatedHeader provides a mock function with given fields: closure
+func (_m *JustPdf) RegisterRepeatedHeader(closure func()) {
+	_m.Called(closure)
+}
+
 // Row provides a mock function with given fields: height, closure
 func (_m *JustPdf) Row(height float64, closure func()) {
 	_m.Called(height, closure)
@@ -300,6 +354,72 @@ func (_m *JustPdf) TableList(header []string, contents [][]string, prop ...props
 	_m.Called(_ca...)
 }
 
+// TableFromCSV provides a mock function with given fields: reader, prop
+func (_m *JustPdf) TableFromCSV(reader io.Reader, prop ...props.TableCSV) error {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, reader)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Reader, ...props.TableCSV) error); ok {
+		r0 = rf(reader, prop...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TableFromIterator provides a mock function with given fields: header, next, prop
+func (_m *JustPdf) TableFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList) {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, header, next)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// TableFromStructs provides a mock function with given fields: slice, prop
+func (_m *JustPdf) TableFromStructs(slice interface{}, prop ...props.TableList) error {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, slice)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...props.TableList) error); ok {
+		r0 = rf(slice, prop...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Table provides a mock function with given fields: header, contents, prop
+func (_m *JustPdf) Table(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, header, contents)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
 // Text provides a mock function with given fields: text, prop
 func (_m *JustPdf) Text(text string, prop ...props.Text) {
 	_va := make([]interface{}, len(prop))
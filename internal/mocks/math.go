@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	consts "github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	props "github.com/muhammadmuhlas/just_pdf/pkg/props"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -26,6 +27,20 @@ func (_m *Math) GetCenterCorrection(outerSize float64, innerSize float64) float6
 	return r0
 }
 
+// GetAlignCorrection provides a mock function with given fields: align, outerSize, innerSize
+func (_m *Math) GetAlignCorrection(align consts.Align, outerSize float64, innerSize float64) float64 {
+	ret := _m.Called(align, outerSize, innerSize)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(consts.Align, float64, float64) float64); ok {
+		r0 = rf(align, outerSize, innerSize)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
 // GetRectCenterColProperties provides a mock function with given fields: imageWidth, imageHeight, qtdCols, colHeight, indexCol, percent
 func (_m *Math) GetRectCenterColProperties(imageWidth float64, imageHeight float64, qtdCols float64, colHeight float64, indexCol float64, percent float64) (float64, float64, float64, float64) {
 	ret := _m.Called(int(imageWidth), int(imageHeight), int(qtdCols), int(colHeight), int(indexCol), int(percent))
@@ -109,3 +124,24 @@ func (_m *Math) GetWidthPerCol(qtdCols float64) float64 {
 
 	return r0
 }
+
+// GetRotatedBoundingBox provides a mock function with given fields: width, height, angle
+func (_m *Math) GetRotatedBoundingBox(width float64, height float64, angle float64) (float64, float64) {
+	ret := _m.Called(width, height, angle)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(float64, float64, float64) float64); ok {
+		r0 = rf(width, height, angle)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 float64
+	if rf, ok := ret.Get(1).(func(float64, float64, float64) float64); ok {
+		r1 = rf(width, height, angle)
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	return r0, r1
+}
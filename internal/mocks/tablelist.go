@@ -27,3 +27,57 @@ func (_m *TableList) Create(header []string, contents [][]string, prop ...props.
 	_ca = append(_ca, _va...)
 	_m.Called(_ca...)
 }
+
+// CreateFromIterator provides a mock function with given fields: header, next, prop
+func (_m *TableList) CreateFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList) {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, header, next)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// CreateFromStructs provides a mock function with given fields: slice, prop
+func (_m *TableList) CreateFromStructs(slice interface{}, prop ...props.TableList) error {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, slice)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...props.TableList) error); ok {
+		r0 = rf(slice, prop...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCells provides a mock function with given fields: header, contents, prop
+func (_m *TableList) CreateCells(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) error {
+	_va := make([]interface{}, len(prop))
+	for _i := range prop {
+		_va[_i] = prop[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, header, contents)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([][]props.TableCell, [][]props.TableCell, ...props.TableList) error); ok {
+		r0 = rf(header, contents, prop...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
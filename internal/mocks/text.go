@@ -28,3 +28,64 @@ func (_m *Text) GetLinesQuantity(text string, fontFamily props.Text, qtdCols flo
 
 	return r0
 }
+
+// GetFitSize provides a mock function with given fields: text, fontFamily, qtdCols, height
+func (_m *Text) GetFitSize(text string, fontFamily props.Text, qtdCols float64, height float64) float64 {
+	ret := _m.Called(text, fontFamily, qtdCols, height)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, props.Text, float64, float64) float64); ok {
+		r0 = rf(text, fontFamily, qtdCols, height)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// GetStringWidth provides a mock function with given fields: text, fontFamily
+func (_m *Text) GetStringWidth(text string, fontFamily props.Text) float64 {
+	ret := _m.Called(text, fontFamily)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, props.Text) float64); ok {
+		r0 = rf(text, fontFamily)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// GetLinesHeight provides a mock function with given fields: text, fontFamily, qtdCols
+func (_m *Text) GetLinesHeight(text string, fontFamily props.Text, qtdCols float64) float64 {
+	ret := _m.Called(text, fontFamily, qtdCols)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, props.Text, float64) float64); ok {
+		r0 = rf(text, fontFamily, qtdCols)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// AddRotated provides a mock function with given fields: text, fontFamily, marginTop, actualCol, qtdCols, colHeight
+func (_m *Text) AddRotated(text string, fontFamily props.Text, marginTop float64, actualCol float64, qtdCols float64, colHeight float64) {
+	_m.Called(text, fontFamily, marginTop, actualCol, qtdCols, colHeight)
+}
+
+// GetRotatedHeight provides a mock function with given fields: text, fontFamily, qtdCols
+func (_m *Text) GetRotatedHeight(text string, fontFamily props.Text, qtdCols float64) float64 {
+	ret := _m.Called(text, fontFamily, qtdCols)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, props.Text, float64) float64); ok {
+		r0 = rf(text, fontFamily, qtdCols)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
@@ -1,7 +1,12 @@
 package internal
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/muhammadmuhlas/just_pdf/pkg/color"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
 )
 
@@ -12,32 +17,103 @@ type JustPdfGridPart interface {
 	SetLRMargins(left, right float64)
 	Col(closure func())
 	ColSpace()
+	KeepWithNext(height float64, closure func())
+	AddPage()
+
+	// Registers
+	RegisterRepeatedHeader(closure func())
+	RegisterRepeatedFooter(closure func())
 
 	// Helpers
 	SetBackgroundColor(color color.Color)
 	GetCurrentOffset() float64
+	GetPageMargins() (float64, float64, float64, float64)
+	GetPageSize() (float64, float64)
+
+	// Inside Col/Row Components
+	Link(link props.Link)
+	DrawLine(x1, y1, x2, y2 float64, prop ...props.Line)
+	FillRect(x, y, width, height float64, color color.Color)
 
 	// Outside Col/Row Components
 	Line(spaceHeight float64)
 }
 
+// inCell execute a closure with the margins of the grid moved to a part of the page, which
+// starts at x from the left margin, so the texts and links added by the closure fill only the part
+func inCell(pdf JustPdfGridPart, x, width float64, closure func()) {
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetPageMargins()
+
+	pdf.SetLRMargins(left+x, pageWidth-left-x-width)
+	closure()
+	pdf.SetLRMargins(left, right)
+}
+
 // TableList is the abstraction to create a table with header and contents
 type TableList interface {
 	Create(header []string, contents [][]string, prop ...props.TableList)
+	CreateCells(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) error
+	CreateFromStructs(slice interface{}, prop ...props.TableList) error
+	CreateFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList)
 	BindGrid(part JustPdfGridPart)
 }
 
+// autoFitPadding is the space between the widest text of an auto-fit column and the next column
+const autoFitPadding = 2.0
+
+// minProportionalWidth is the smallest width in mm of a proportional column for each unit of its weight
+const minProportionalWidth = 10.0
+
+// cellPadding is the space between the limits of a cell and its image, code or checkbox
+const cellPadding = 1.0
+
+// tableCell is a cell placed in the grid of a table, at its first row and column
+type tableCell struct {
+	props.TableCell
+	row   int
+	col   int
+	style props.CellStyle
+}
+
+// tableBorders are the lines drawn in a table, the ones which are nil aren't drawn
+type tableBorders struct {
+	top        *props.Line
+	right      *props.Line
+	bottom     *props.Line
+	left       *props.Line
+	horizontal *props.Line
+	vertical   *props.Line
+	header     *props.Line
+}
+
+// tableAggregates is the aggregation of the values of each column of a table
+type tableAggregates struct {
+	sums    []float64
+	mins    []float64
+	maxs    []float64
+	numbers []int
+	counts  []int
+}
+
 type tableList struct {
-	pdf  JustPdfGridPart
-	text Text
-	font Font
+	pdf   JustPdfGridPart
+	text  Text
+	font  Font
+	image Image
+	code  Code
+	bidi  Bidi
+	err   error
 }
 
 // NewTableList create a TableList
-func NewTableList(text Text, font Font) *tableList {
+func NewTableList(text Text, font Font, image Image, code Code) *tableList {
 	return &tableList{
-		text: text,
-		font: font,
+		text:  text,
+		font:  font,
+		image: image,
+		code:  code,
+		bidi:  NewBidi(),
 	}
 }
 
@@ -57,6 +133,89 @@ func (s *tableList) Create(header []string, contents [][]string, prop ...props.T
 		return
 	}
 
+	contentCells := make([][]props.TableCell, len(contents))
+	for index, content := range contents {
+		contentCells[index] = s.toCells(content)
+	}
+
+	// The cells are only texts, which are always written
+	s.CreateCells([][]props.TableCell{s.toCells(header)}, contentCells, prop...)
+}
+
+// CreateCells create a header section with one or more rows of cells and
+// create many rows with contents. Cells can be merged with the next columns
+// and rows, the header defines the amount of columns of the table. An image
+// or a barcode which can't be added leaves its cell empty, the whole table is
+// added and the first error is returned
+func (s *tableList) CreateCells(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) error {
+	if len(header) == 0 {
+		return nil
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+
+	headerGrid, qtdCols := s.placeCells(header, 0)
+	if qtdCols == 0 {
+		return nil
+	}
+
+	contentGrid, _ := s.placeCells(contents, qtdCols)
+
+	tableProp := props.TableList{}
+
+	if len(prop) > 0 {
+		tableProp = prop[0]
+	}
+
+	tableProp.MakeValid()
+
+	s.applyStyles(contentGrid, tableProp)
+
+	start := 0
+	bands := s.getBands(contentGrid)
+	s.err = nil
+
+	s.addTable(headerGrid, contentGrid, qtdCols, tableProp, func() ([][]tableCell, bool) {
+		if len(bands) == 0 {
+			return nil, false
+		}
+
+		end := bands[0]
+		bands = bands[1:]
+
+		band := contentGrid[start:end]
+		start = end
+
+		return band, true
+	})
+
+	return s.err
+}
+
+// CreateFromIterator create a TableList with the contents returned by next, which are drawn
+// while they are read, so all contents aren't kept in memory. When the header is nil the
+// first contents are the header. The widths of the columns are known only from the header
+func (s *tableList) CreateFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList) {
+	if header == nil {
+		first, ok := next()
+		if !ok {
+			return
+		}
+
+		header = first
+	}
+
+	if len(header) == 0 {
+		return
+	}
+
+	headerGrid, qtdCols := s.placeCells([][]props.TableCell{s.toCells(header)}, 0)
+	if qtdCols == 0 {
+		return
+	}
+
 	tableProp := props.TableList{}
 
 	if len(prop) > 0 {
@@ -65,101 +224,1302 @@ func (s *tableList) Create(header []string, contents [][]string, prop ...props.T
 
 	tableProp.MakeValid()
 
-	qtdCols := float64(len(header))
+	// The table isn't drawn without contents, so the first contents are read before the header
+	content, ok := next()
+	if !ok {
+		return
+	}
+
+	row := 0
+
+	s.addTable(headerGrid, nil, qtdCols, tableProp, func() ([][]tableCell, bool) {
+		if row > 0 {
+			content, ok = next()
+			if !ok {
+				return nil, false
+			}
+		}
+
+		band, _ := s.placeCells([][]props.TableCell{s.toCells(content)}, qtdCols)
+		for index := range band[0] {
+			band[0][index].row = row
+		}
+
+		s.applyStyles(band, tableProp)
+		row++
+
+		return band, true
+	})
+}
+
+// addTable draw the header and each band of contents returned by next, the contents are used only to
+// calculate the widths of the columns
+func (s *tableList) addTable(headerGrid, contents [][]tableCell, qtdCols int, tableProp props.TableList,
+	next func() ([][]tableCell, bool)) {
+	tableProp = s.resolveDirection(headerGrid, contents, tableProp)
 
 	headerTextProp := tableProp.HeaderProp.ToTextProp(tableProp.Align, 0.0, false, 1.0)
-	headerHeight := s.calcLinesHeight(header, headerTextProp, qtdCols)
+	headerTextProp.Direction = tableProp.Direction
+	contentTextProp := tableProp.ContentProp.ToTextProp(tableProp.Align, 0.0, false, 0.2)
+	contentTextProp.Direction = tableProp.Direction
+
+	widths := s.getColumnWidths(qtdCols, headerGrid, contents, tableProp, headerTextProp, contentTextProp)
+	headerHeights := s.getHeights(headerGrid, widths, headerTextProp)
+	borders := s.getBorders(tableProp)
 
 	// Draw header
-	s.pdf.Row(headerHeight, func() {
-		headerMarginTop := 2.0
+	s.addHeader(headerGrid, headerHeights, widths, tableProp, headerTextProp, borders)
 
-		for i, h := range header {
-			hs := h
-			is := i
+	var aggregates *tableAggregates
+	if tableProp.Footer != nil {
+		aggregates = newTableAggregates(qtdCols)
+	}
 
-			s.pdf.SetBackgroundColor(*tableProp.HeaderColor)
-			s.pdf.Col(func() {
-				if headerMarginTop > headerHeight {
-					headerMarginTop = headerHeight
+	carriedForward := tableProp.Footer != nil && tableProp.Footer.CarriedForward
+
+	// The header is drawn again in each page which the contents continue
+	if tableProp.RepeatHeader || carriedForward || borders.top != nil {
+		s.pdf.RegisterRepeatedHeader(func() {
+			if tableProp.RepeatHeader {
+				if tableProp.ContinuedCaption != "" {
+					s.addCaption(tableProp.ContinuedCaption, tableProp)
 				}
 
-				reason := hs
+				s.addHeader(headerGrid, headerHeights, widths, tableProp, headerTextProp, borders)
+			} else if borders.top != nil {
+				s.addRule(*borders.top, widths)
+			}
 
-				sumOyYOffesets := headerMarginTop + s.pdf.GetCurrentOffset() + 2.5
-				headerTextProp.Align = tableProp.CustomAlign[is]
-				headerTextProp.Color = color.NewWhite()
-				s.text.Add(reason, headerTextProp, sumOyYOffesets, float64(is), qtdCols)
-			})
+			if carriedForward {
+				s.addFooter(tableProp.Footer.BroughtForwardLabel, aggregates, widths, tableProp, borders)
+			}
+		})
+		defer s.pdf.RegisterRepeatedHeader(nil)
+	}
+
+	// The totals until the end of the page are drawn before the page break
+	if carriedForward || borders.bottom != nil {
+		s.pdf.RegisterRepeatedFooter(func() {
+			if carriedForward {
+				s.addFooter(tableProp.Footer.CarriedForwardLabel, aggregates, widths, tableProp, borders)
+			}
+
+			if borders.bottom != nil {
+				s.addRule(*borders.bottom, widths)
+			}
+		})
+		defer s.pdf.RegisterRepeatedFooter(nil)
+	}
+
+	group := tableProp.Group
+	key := ""
+	var groupAggregates *tableAggregates
+
+	// Draw contents
+	for band := 0; ; band++ {
+		rows, ok := next()
+		if !ok {
+			break
+		}
+
+		// A group finishes when the key of the next contents is another
+		if group != nil {
+			bandKey := group.Key(s.getTexts(rows[0], qtdCols))
+
+			if band == 0 || bandKey != key {
+				if band > 0 {
+					s.addGroupFooter(key, groupAggregates, widths, tableProp, borders)
+
+					if group.PageBreak {
+						s.pdf.AddPage()
+					}
+				}
+
+				key = bandKey
+				groupAggregates = newTableAggregates(qtdCols)
+				s.addGroupHeader(key, widths, tableProp, borders)
+			}
 		}
+
+		heights := s.getHeights(rows, widths, contentTextProp)
+		s.addContentBand(rows, heights, 0, len(rows), band, widths, tableProp, contentTextProp, aggregates, borders)
+
+		if groupAggregates != nil {
+			groupAggregates.add(rows)
+		}
+	}
+
+	if groupAggregates != nil {
+		s.addGroupFooter(key, groupAggregates, widths, tableProp, borders)
+	}
+
+	if tableProp.Footer != nil {
+		s.addFooter("", aggregates, widths, tableProp, borders)
+	}
+
+	if borders.bottom != nil {
+		s.addRule(*borders.bottom, widths)
+	}
+}
+
+// addHeader add one Row with all rows of the header, with the background of the header
+func (s *tableList) addHeader(header [][]tableCell, heights []float64, widths []float64, tableProp props.TableList,
+	headerTextProp props.Text, borders tableBorders) {
+	s.pdf.Row(s.sumHeights(heights, 0, len(heights)), func() {
+		s.pdf.SetBackgroundColor(*tableProp.HeaderColor)
+		s.pdf.Col(func() {
+			top := 0.0
+
+			for index, cells := range header {
+				headerMarginTop := 2.0
+				if headerMarginTop > heights[index] {
+					headerMarginTop = heights[index]
+				}
+
+				for _, cell := range cells {
+					reason := cell.Text
+
+					sumOyYOffesets := top + headerMarginTop + s.pdf.GetCurrentOffset() + 2.5
+					headerTextProp.Align = s.getAlign(tableProp, cell.col)
+					headerTextProp.Color = color.NewWhite()
+
+					s.inCell(s.getCellX(cell, widths, tableProp), s.getCellWidth(cell, widths), func() {
+						s.text.Add(reason, headerTextProp, sumOyYOffesets, 0, 1)
+					})
+				}
+
+				top += heights[index]
+			}
+
+			s.addBandBorders(header, heights, 0, len(header), widths, tableProp, borders, borders.header)
+
+			if borders.top != nil {
+				s.pdf.DrawLine(0, 0, s.getTableWidth(widths), 0, *borders.top)
+			}
+		})
 	})
+}
 
+// addContentBand add one Row with the content rows from start until end, which are merged by
+// its cells. Merged cells are never split by a page break or by the line between rows
+func (s *tableList) addContentBand(contents [][]tableCell, heights []float64, start, end, band int,
+	widths []float64, tableProp props.TableList, contentTextProp props.Text, aggregates *tableAggregates,
+	borders tableBorders) {
 	contentMarginTop := 0.7
 
-	// Draw contents
-	for index, content := range contents {
-		contentTextProp := tableProp.ContentProp.ToTextProp(tableProp.Align, 0.0, false, 0.2)
-		contentHeight := s.calcLinesContentHeight(content, contentTextProp, qtdCols)
-
-		s.pdf.Row(contentHeight, func() {
-			if (tableProp.AlternatedBackground != nil || tableProp.AlternatedOddBackground != nil) && index%2 == 0 {
-				s.pdf.SetBackgroundColor(*tableProp.AlternatedBackground)
-			} else {
-				s.pdf.SetBackgroundColor(*tableProp.AlternatedOddBackground)
-			}
-			for j, c := range content {
-				cs := c
-				js := j
-				hs := float64(len(header))
-
-				sumOyYOffesets := contentMarginTop + s.pdf.GetCurrentOffset() + 2.0
-				s.pdf.Col(func() {
-					contentTextProp.Align = tableProp.CustomAlign[js]
+	// The lines of Line are drawn only in the tables without borders
+	line := tableProp.Line && tableProp.Border == "" && tableProp.Borders == nil
+
+	s.pdf.Row(s.sumHeights(heights, start, end), func() {
+		s.pdf.SetBackgroundColor(s.getBackground(tableProp, band))
+		s.pdf.Col(func() {
+			top := 0.0
+
+			for index := start; index < end; index++ {
+				for _, cell := range contents[index] {
+					cs := cell.Text
+					link := s.getLink(tableProp, cell.row, cell.col)
+					x := s.getCellX(cell, widths, tableProp)
+					width := s.getCellWidth(cell, widths)
+					cellHeight := s.sumHeights(heights, index, index+cell.RowSpan)
+
+					contentTextProp.Align = s.getAlign(tableProp, cell.col)
 					contentTextProp.Color = *tableProp.ContentFontColor
-					s.text.Add(cs, contentTextProp, sumOyYOffesets, float64(js), hs)
-				})
+					cellTextProp := s.getCellTextProp(contentTextProp, cell.style)
+
+					// Texts with another font size are moved in proportion to it
+					sumOyYOffesets := top + contentMarginTop + s.pdf.GetCurrentOffset() +
+						2.0*cellTextProp.Size/contentTextProp.Size
+
+					if cell.style.BackgroundColor != nil {
+						s.pdf.FillRect(x, top, width, cellHeight, *cell.style.BackgroundColor)
+					}
+
+					if cell.Content != nil {
+						s.addContent(cell.Content, x, width, top, cellHeight, cellTextProp, link)
+					} else {
+						s.inCell(x, width, func() {
+							s.text.Add(cs, cellTextProp, sumOyYOffesets, 0, 1)
+							s.pdf.Link(link)
+						})
+					}
+
+					// Cells which finish inside the band are separated from the cells below them
+					bottom := index + cell.RowSpan
+					if line && bottom < end {
+						y := s.sumHeights(heights, start, bottom)
+						s.pdf.DrawLine(x, y, x+width, y)
+					}
+
+					if cell.style.Border != nil || cell.style.Borders != nil {
+						s.addBorder(x, top, width, cellHeight, cell.style)
+					}
+				}
+
+				top += heights[index]
 			}
+
+			s.addBandBorders(contents, heights, start, end, widths, tableProp, borders, borders.horizontal)
 		})
-		s.pdf.SetBackgroundColor(color.NewWhite())
 
-		if tableProp.Line {
-			s.pdf.Line(1.0)
+		// The values are aggregated when the band is drawn, after the page break
+		if aggregates != nil {
+			aggregates.add(contents[start:end])
 		}
+	})
+	s.pdf.SetBackgroundColor(color.NewWhite())
+
+	if line {
+		s.pdf.Line(1.0)
 	}
 }
 
-func (s *tableList) calcLinesHeight(textList []string, textProp props.Text, qtdCols float64) float64 {
-	maxLines := 2.0
+// addContent draw the content of a cell, which starts at top from the top of the Row and has the height
+func (s *tableList) addContent(content props.Cell, x, width, top, height float64, contentTextProp props.Text, link props.Link) {
+	offsetY := s.pdf.GetCurrentOffset() + top
+
+	if checkbox, ok := content.(props.CheckboxCell); ok {
+		s.addCheckbox(checkbox, x, width, top, contentTextProp)
+	}
+
+	s.inCell(x, width, func() {
+		switch c := content.(type) {
+		case props.TextCell:
+			if c.Prop.Rotation != 0 {
+				s.text.AddRotated(c.Text, c.Prop, offsetY+cellPadding+c.Prop.Top, 0, 1, height-2.0*cellPadding-c.Prop.Top)
+				break
+			}
 
-	for _, text := range textList {
-		qtdLines := float64(s.text.GetLinesQuantity(text, textProp, qtdCols))
-		if qtdLines > maxLines {
-			maxLines = qtdLines
+			// The baseline is moved like the one of the contents, by the font height
+			fontHeight := c.Prop.Size / s.font.GetScaleFactor()
+			s.text.Add(c.Text, c.Prop, offsetY+c.Prop.Top+0.7+fontHeight*0.6, 0, 1)
+		case props.ImageCell:
+			s.addError(s.image.AddFromBase64(c.Base64, offsetY+cellPadding+c.Prop.Top, 0, 1, c.Height, c.Prop, c.Extension))
+		case props.BarcodeCell:
+			s.addError(s.code.AddBar(c.Code, offsetY+cellPadding+c.Prop.Top, 0, 1, c.Height, c.Prop))
+		case props.QrCodeCell:
+			s.code.AddQr(c.Code, offsetY+cellPadding+c.Prop.Top, 0, 1, c.Height, c.Prop)
 		}
+
+		s.pdf.Link(link)
+	})
+}
+
+// addError record the first error of a content which can't be added, the cell is left
+// empty and the error is returned when the table is finished
+func (s *tableList) addError(err error) {
+	if err != nil && s.err == nil {
+		s.err = err
 	}
+}
 
-	_, _, fontSize := s.font.GetFont()
+// addCheckbox draw the box of a checkbox aligned like the texts of its column, and
+// the check mark inside it when checked
+func (s *tableList) addCheckbox(checkbox props.CheckboxCell, x, width, top float64, contentTextProp props.Text) {
+	boxX := x + cellPadding
+	switch contentTextProp.Align {
+	case consts.Center:
+		boxX = x + (width-checkbox.Size)/2.0
+	case consts.Right:
+		boxX = x + width - checkbox.Size - cellPadding
+	}
+
+	boxY := top + cellPadding
+
+	s.pdf.DrawLine(boxX, boxY, boxX+checkbox.Size, boxY)
+	s.pdf.DrawLine(boxX+checkbox.Size, boxY, boxX+checkbox.Size, boxY+checkbox.Size)
+	s.pdf.DrawLine(boxX+checkbox.Size, boxY+checkbox.Size, boxX, boxY+checkbox.Size)
+	s.pdf.DrawLine(boxX, boxY+checkbox.Size, boxX, boxY)
+
+	if !checkbox.Checked {
+		return
+	}
+
+	// The check mark of ZapfDingbats fills most of the box
+	markProp := props.Text{
+		Family: consts.ZapBats,
+		Size:   checkbox.Size * s.font.GetScaleFactor() * 0.8,
+		Align:  consts.Center,
+		Color:  contentTextProp.Color,
+	}
+
+	s.inCell(boxX, checkbox.Size, func() {
+		s.text.Add("4", markProp, s.pdf.GetCurrentOffset()+boxY+checkbox.Size*0.8, 0, 1)
+	})
+}
+
+// getContentHeight return the height of a row which fits the content of a cell with the width
+func (s *tableList) getContentHeight(content props.Cell, width float64) float64 {
+	switch c := content.(type) {
+	case props.TextCell:
+		if c.Prop.Rotation != 0 {
+			return s.getRotatedTextHeight(c.Text, c.Prop, width)
+		}
+
+		return s.getTextHeight(c.Text, c.Prop, width)
+	case props.ImageCell:
+		return c.Height + 2.0*cellPadding
+	case props.BarcodeCell:
+		return c.Height + 2.0*cellPadding
+	case props.QrCodeCell:
+		return c.Height + 2.0*cellPadding
+	case props.CheckboxCell:
+		return c.Size + 2.0*cellPadding
+	}
+
+	return 0.0
+}
+
+// getTextHeight return the height of a row which fits a text with its own properties in a cell with the width
+func (s *tableList) getTextHeight(text string, textProp props.Text, width float64) float64 {
+	qtdLines := s.getLinesQuantity(text, textProp, width)
 
 	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
-	fontHeight := fontSize / s.font.GetScaleFactor()
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+	if textProp.LineHeight > 0 {
+		fontHeight = textProp.LineHeight
+	}
+
+	return fontHeight*qtdLines + s.getLinesExtra(text, qtdLines, fontHeight, textProp) + 3.0
+}
+
+// getRotatedTextHeight return the height of a row which fits a rotated text in a cell with the width
+func (s *tableList) getRotatedTextHeight(text string, textProp props.Text, width float64) float64 {
+	height := 0.0
+
+	s.inCell(0.0, width, func() {
+		height = s.text.GetRotatedHeight(text, textProp, 1)
+	})
 
-	return fontHeight*maxLines + 3.0
+	return height + textProp.Top + 2.0*cellPadding
 }
 
-func (s *tableList) calcLinesContentHeight(textList []string, textProp props.Text, qtdCols float64) float64 {
-	maxLines := 2.0
+// addBorder draw the lines around a cell, which starts at top from the top of the Row,
+// the sides of Borders override the line of Border
+func (s *tableList) addBorder(x, top, width, height float64, style props.CellStyle) {
+	borders := props.Borders{Top: style.Border, Right: style.Border, Bottom: style.Border, Left: style.Border}
+
+	if style.Borders != nil {
+		if style.Borders.Top != nil {
+			borders.Top = style.Borders.Top
+		}
+
+		if style.Borders.Right != nil {
+			borders.Right = style.Borders.Right
+		}
+
+		if style.Borders.Bottom != nil {
+			borders.Bottom = style.Borders.Bottom
+		}
 
-	for _, text := range textList {
-		qtdLines := float64(s.text.GetLinesQuantity(text, textProp, qtdCols))
-		if qtdLines > maxLines {
-			maxLines = qtdLines
+		if style.Borders.Left != nil {
+			borders.Left = style.Borders.Left
 		}
 	}
 
-	_, _, fontSize := s.font.GetFont()
+	if borders.Top != nil {
+		s.pdf.DrawLine(x, top, x+width, top, *borders.Top)
+	}
 
-	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
-	fontHeight := fontSize / s.font.GetScaleFactor()
+	if borders.Right != nil {
+		s.pdf.DrawLine(x+width, top, x+width, top+height, *borders.Right)
+	}
+
+	if borders.Bottom != nil {
+		s.pdf.DrawLine(x+width, top+height, x, top+height, *borders.Bottom)
+	}
+
+	if borders.Left != nil {
+		s.pdf.DrawLine(x, top+height, x, top, *borders.Left)
+	}
+}
+
+// addBandBorders draw the lines between the cells of the rows from start to end, which
+// are drawn in the same Row, the sides of the table and the line below the rows
+func (s *tableList) addBandBorders(rows [][]tableCell, heights []float64, start, end int, widths []float64,
+	tableProp props.TableList, borders tableBorders, below *props.Line) {
+	tableWidth := s.getTableWidth(widths)
+	height := s.sumHeights(heights, start, end)
+	top := 0.0
+
+	for index := start; index < end; index++ {
+		for _, cell := range rows[index] {
+			x := s.getCellX(cell, widths, tableProp)
+			width := s.getCellWidth(cell, widths)
+
+			// The cells at the left of the table are bounded by its side
+			leftmost := cell.col == 0
+			if tableProp.Direction == consts.RightToLeft {
+				leftmost = cell.col+cell.ColSpan >= len(widths)
+			}
+
+			if borders.vertical != nil && !leftmost {
+				s.pdf.DrawLine(x, top, x, top+s.sumHeights(heights, index, index+cell.RowSpan), *borders.vertical)
+			}
+
+			bottom := index + cell.RowSpan
+			if borders.horizontal != nil && bottom < end {
+				y := s.sumHeights(heights, start, bottom)
+				s.pdf.DrawLine(x, y, x+width, y, *borders.horizontal)
+			}
+		}
+
+		top += heights[index]
+	}
+
+	if below != nil {
+		s.pdf.DrawLine(0, height, tableWidth, height, *below)
+	}
+
+	if borders.left != nil {
+		s.pdf.DrawLine(0, 0, 0, height, *borders.left)
+	}
+
+	if borders.right != nil {
+		s.pdf.DrawLine(tableWidth, 0, tableWidth, height, *borders.right)
+	}
+}
+
+// addRule add a Row without height with a line across the table, ex: the bottom of the table before a page break
+func (s *tableList) addRule(line props.Line, widths []float64) {
+	s.pdf.Row(0.0, func() {
+		s.pdf.Col(func() {
+			s.pdf.DrawLine(0, 0, s.getTableWidth(widths), 0, line)
+		})
+	})
+}
+
+// getBorders return the lines drawn by the preset of the table, with its sides overridden by Borders
+func (s *tableList) getBorders(tableProp props.TableList) tableBorders {
+	line := tableProp.BorderLine
+	borders := tableBorders{}
+
+	switch tableProp.Border {
+	case consts.HorizontalRules:
+		borders.horizontal = &line
+		borders.header = &line
+	case consts.FullGrid:
+		borders = tableBorders{
+			top: &line, right: &line, bottom: &line, left: &line,
+			horizontal: &line, vertical: &line, header: &line,
+		}
+	case consts.OuterBox:
+		borders = tableBorders{top: &line, right: &line, bottom: &line, left: &line}
+	case consts.HeaderUnderline:
+		borders.header = &line
+	}
+
+	if tableProp.Borders != nil {
+		if tableProp.Borders.Top != nil {
+			borders.top = tableProp.Borders.Top
+		}
+
+		if tableProp.Borders.Right != nil {
+			borders.right = tableProp.Borders.Right
+		}
+
+		if tableProp.Borders.Bottom != nil {
+			borders.bottom = tableProp.Borders.Bottom
+		}
+
+		if tableProp.Borders.Left != nil {
+			borders.left = tableProp.Borders.Left
+		}
+	}
+
+	return borders
+}
+
+// applyStyles define the style of each content cell, overriding the style of its row, then
+// the styles of the rules which it matches and then its own style
+func (s *tableList) applyStyles(rows [][]tableCell, tableProp props.TableList) {
+	for index, cells := range rows {
+		if len(cells) == 0 {
+			continue
+		}
+
+		// The position of the row in the contents, which can be drawn in parts
+		row := cells[0].row
+
+		rowStyle := props.CellStyle{}
+		if row < len(tableProp.RowStyles) {
+			rowStyle = tableProp.RowStyles[row]
+		}
+
+		for _, rule := range tableProp.StyleRules {
+			if rule.Row && s.matchRow(rule, cells) {
+				rowStyle = s.mergeStyle(rowStyle, rule.Style)
+			}
+		}
+
+		for position, cell := range cells {
+			style := rowStyle
+
+			for _, rule := range tableProp.StyleRules {
+				if !rule.Row && rule.Column == cell.col && s.matchRule(rule, cell.Text) {
+					style = s.mergeStyle(style, rule.Style)
+				}
+			}
+
+			if row < len(tableProp.CellStyles) && cell.col < len(tableProp.CellStyles[row]) {
+				style = s.mergeStyle(style, tableProp.CellStyles[row][cell.col])
+			}
+
+			rows[index][position].style = style
+		}
+	}
+}
+
+// matchRow return if a cell of the row, in the column of a rule, matches it
+func (s *tableList) matchRow(rule props.StyleRule, cells []tableCell) bool {
+	for _, cell := range cells {
+		if cell.col == rule.Column && s.matchRule(rule, cell.Text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchRule return if a text matches the condition of a rule, texts which aren't numbers
+// never match the operators which compare numbers
+func (s *tableList) matchRule(rule props.StyleRule, text string) bool {
+	text = strings.TrimSpace(text)
+
+	switch rule.Operator {
+	case consts.Equal:
+		return text == rule.Value
+	case consts.NotEqual:
+		return text != rule.Value
+	case consts.Contains:
+		return strings.Contains(text, rule.Value)
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return false
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(rule.Value), 64)
+	if err != nil {
+		return false
+	}
+
+	switch rule.Operator {
+	case consts.LessThan:
+		return number < value
+	case consts.LessOrEqual:
+		return number <= value
+	case consts.GreaterThan:
+		return number > value
+	case consts.GreaterOrEqual:
+		return number >= value
+	}
+
+	return false
+}
+
+// mergeStyle return a style with the fields which are defined in the override replacing the ones of the style
+func (s *tableList) mergeStyle(style props.CellStyle, override props.CellStyle) props.CellStyle {
+	if override.BackgroundColor != nil {
+		style.BackgroundColor = override.BackgroundColor
+	}
+
+	if override.Font != nil {
+		style.Font = override.Font
+	}
+
+	if override.TextColor != nil {
+		style.TextColor = override.TextColor
+	}
+
+	if override.Border != nil {
+		style.Border = override.Border
+	}
+
+	if override.Borders != nil {
+		style.Borders = override.Borders
+	}
+
+	return style
+}
+
+// getCellTextProp return the properties of the text of a content cell, with the font and the color of its style
+func (s *tableList) getCellTextProp(contentTextProp props.Text, style props.CellStyle) props.Text {
+	if style.Font != nil {
+		if style.Font.Family != "" {
+			contentTextProp.Family = style.Font.Family
+		}
+
+		if style.Font.Style != "" {
+			contentTextProp.Style = style.Font.Style
+		}
+
+		if style.Font.Size > 0.0 {
+			contentTextProp.Size = style.Font.Size
+		}
+	}
+
+	if style.TextColor != nil {
+		contentTextProp.Color = *style.TextColor
+	}
+
+	return contentTextProp
+}
+
+// makeValidContent return the content of a cell with its default values
+func (s *tableList) makeValidContent(content props.Cell) props.Cell {
+	switch c := content.(type) {
+	case props.TextCell:
+		c.MakeValid()
+		return c
+	case props.ImageCell:
+		c.MakeValid()
+		return c
+	case props.BarcodeCell:
+		c.MakeValid()
+		return c
+	case props.QrCodeCell:
+		c.MakeValid()
+		return c
+	case props.CheckboxCell:
+		c.MakeValid()
+		return c
+	}
+
+	return content
+}
+
+// addFooter add the Row of the footer, with the aggregation of the values drawn until now. The
+// label, when it isn't empty, is the text of the first column without aggregation
+func (s *tableList) addFooter(label string, aggregates *tableAggregates, widths []float64, tableProp props.TableList,
+	borders tableBorders) {
+	texts := make([]string, len(widths))
+	for index := range texts {
+		if index < len(tableProp.Footer.Cells) {
+			texts[index] = aggregates.format(index, tableProp.Footer.Cells[index], tableProp.Footer.Format)
+		}
+	}
+
+	if label != "" {
+		for index := range texts {
+			if index >= len(tableProp.Footer.Cells) || tableProp.Footer.Cells[index].Aggregation == "" {
+				texts[index] = label
+				break
+			}
+		}
+	}
+
+	s.addFooterRow(texts, widths, tableProp, borders)
+}
+
+// addGroupHeader add the row above the contents of a group, which is kept in the page of the first content
+func (s *tableList) addGroupHeader(key string, widths []float64, tableProp props.TableList, borders tableBorders) {
+	groupTextProp := tableProp.HeaderProp.ToTextProp(tableProp.Align, 0.0, false, 0.2)
+	groupTextProp.Direction = tableProp.Direction
+	groupTextProp.Color = *tableProp.ContentFontColor
+
+	text := tableProp.Group.Header(key)
+	cell := tableCell{TableCell: props.TableCell{Text: text, ColSpan: len(widths), RowSpan: 1}}
+	tableWidth := s.getTableWidth(widths)
+	height := s.calcLinesHeight([]string{text}, groupTextProp, []float64{tableWidth})
+
+	s.pdf.KeepWithNext(height, func() {
+		s.pdf.Row(height, func() {
+			s.pdf.Col(func() {
+				s.inCell(0, tableWidth, func() {
+					s.text.Add(text, groupTextProp, 0.7+s.pdf.GetCurrentOffset()+2.0, 0, 1)
+				})
+
+				s.addBandBorders([][]tableCell{{cell}}, []float64{height}, 0, 1, widths, tableProp, borders,
+					borders.horizontal)
+			})
+		})
+	})
+}
+
+// addGroupFooter add the row after the contents of a group, with the texts of the Footer
+// of the group overridden by its subtotals
+func (s *tableList) addGroupFooter(key string, aggregates *tableAggregates, widths []float64,
+	tableProp props.TableList, borders tableBorders) {
+	group := tableProp.Group
+	if group.Footer == nil && len(group.Subtotals) == 0 {
+		return
+	}
+
+	texts := make([]string, len(widths))
+	if group.Footer != nil {
+		copy(texts, group.Footer(key))
+	}
+
+	for index, cell := range group.Subtotals {
+		if index < len(texts) && (cell.Text != "" || cell.Aggregation != "") {
+			texts[index] = aggregates.format(index, cell, group.Format)
+		}
+	}
+
+	s.addFooterRow(texts, widths, tableProp, borders)
+}
+
+// addFooterRow add a row with a text in each column, with the font of the header
+func (s *tableList) addFooterRow(texts []string, widths []float64, tableProp props.TableList, borders tableBorders) {
+	footerTextProp := tableProp.HeaderProp.ToTextProp(tableProp.Align, 0.0, false, 0.2)
+	footerTextProp.Direction = tableProp.Direction
+	footerTextProp.Color = *tableProp.ContentFontColor
+
+	footerHeight := s.calcLinesHeight(texts, footerTextProp, widths)
+
+	cells := make([]tableCell, len(texts))
+	for index, text := range texts {
+		cells[index] = tableCell{TableCell: props.TableCell{Text: text, ColSpan: 1, RowSpan: 1}, col: index}
+	}
+
+	s.pdf.Row(footerHeight, func() {
+		s.pdf.Col(func() {
+			for index, cell := range cells {
+				footerText := cell.Text
+
+				sumOyYOffesets := 0.7 + s.pdf.GetCurrentOffset() + 2.0
+				footerTextProp.Align = s.getAlign(tableProp, index)
+
+				s.inCell(s.getCellX(cell, widths, tableProp), widths[index], func() {
+					s.text.Add(footerText, footerTextProp, sumOyYOffesets, 0, 1)
+				})
+			}
+
+			s.addBandBorders([][]tableCell{cells}, []float64{footerHeight}, 0, 1, widths, tableProp, borders,
+				borders.horizontal)
+		})
+	})
+}
+
+// addCaption add a Row with a caption above the header repeated in a new page, ex: "(continued)"
+func (s *tableList) addCaption(caption string, tableProp props.TableList) {
+	captionTextProp := tableProp.ContentProp.ToTextProp(tableProp.Align, 0.0, false, 0.0)
+	captionTextProp.Style = consts.Italic
+	captionTextProp.Direction = tableProp.Direction
+	captionTextProp.Color = *tableProp.ContentFontColor
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	captionHeight := captionTextProp.Size/s.font.GetScaleFactor() + 3.0
+
+	s.pdf.Row(captionHeight, func() {
+		s.pdf.Col(func() {
+			s.text.Add(caption, captionTextProp, s.pdf.GetCurrentOffset()+1.0, 0, 1)
+		})
+	})
+}
+
+// newTableAggregates create the aggregation of the values of the columns, without values
+func newTableAggregates(qtdCols int) *tableAggregates {
+	return &tableAggregates{
+		sums:    make([]float64, qtdCols),
+		mins:    make([]float64, qtdCols),
+		maxs:    make([]float64, qtdCols),
+		numbers: make([]int, qtdCols),
+		counts:  make([]int, qtdCols),
+	}
+}
+
+// add aggregate the values of the cells of the rows, cells merged with the
+// next columns and cells with content aren't aggregated
+func (s *tableAggregates) add(rows [][]tableCell) {
+	for _, cells := range rows {
+		for _, cell := range cells {
+			if cell.ColSpan > 1 || cell.Content != nil {
+				continue
+			}
+
+			text := strings.TrimSpace(cell.Text)
+			if text == "" {
+				continue
+			}
+
+			s.counts[cell.col]++
+
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				continue
+			}
+
+			if s.numbers[cell.col] == 0 || value < s.mins[cell.col] {
+				s.mins[cell.col] = value
+			}
+
+			if s.numbers[cell.col] == 0 || value > s.maxs[cell.col] {
+				s.maxs[cell.col] = value
+			}
+
+			s.sums[cell.col] += value
+			s.numbers[cell.col]++
+		}
+	}
+}
+
+// format return the text of a footer cell, its aggregation formatted or its text. Columns
+// without numbers have an empty average, min and max
+func (s *tableAggregates) format(col int, cell props.FooterCell, format string) string {
+	switch cell.Aggregation {
+	case consts.Count:
+		return strconv.Itoa(s.counts[col])
+	case consts.Sum:
+		return fmt.Sprintf(format, s.sums[col])
+	}
+
+	if s.numbers[col] == 0 {
+		if cell.Aggregation == "" {
+			return cell.Text
+		}
+
+		return ""
+	}
+
+	switch cell.Aggregation {
+	case consts.Average:
+		return fmt.Sprintf(format, s.sums[col]/float64(s.numbers[col]))
+	case consts.Min:
+		return fmt.Sprintf(format, s.mins[col])
+	case consts.Max:
+		return fmt.Sprintf(format, s.maxs[col])
+	}
+
+	return cell.Text
+}
+
+// toCells return a cell to each text, without merged cells
+func (s *tableList) toCells(texts []string) []props.TableCell {
+	cells := make([]props.TableCell, len(texts))
+	for index, text := range texts {
+		cells[index] = props.TableCell{Text: text}
+	}
+
+	return cells
+}
+
+// placeCells place the cells of each row in the first columns which aren't occupied by cells merged
+// from the rows above, and return the amount of columns. When limit is greater than zero, cells
+// after the limit are discarded
+func (s *tableList) placeCells(rows [][]props.TableCell, limit int) ([][]tableCell, int) {
+	placed := make([][]tableCell, len(rows))
+	occupied := make([][]bool, len(rows))
+	qtdCols := 0
+
+	for row, cells := range rows {
+		col := 0
+
+		for _, cell := range cells {
+			cell.MakeValid()
+			cell.Content = s.makeValidContent(cell.Content)
+
+			for col < len(occupied[row]) && occupied[row][col] {
+				col++
+			}
+
+			if limit > 0 && col >= limit {
+				break
+			}
+
+			if limit > 0 && col+cell.ColSpan > limit {
+				cell.ColSpan = limit - col
+			}
+
+			if row+cell.RowSpan > len(rows) {
+				cell.RowSpan = len(rows) - row
+			}
+
+			for r := row; r < row+cell.RowSpan; r++ {
+				for len(occupied[r]) < col+cell.ColSpan {
+					occupied[r] = append(occupied[r], false)
+				}
+
+				for c := col; c < col+cell.ColSpan; c++ {
+					occupied[r][c] = true
+				}
+			}
+
+			placed[row] = append(placed[row], tableCell{TableCell: cell, row: row, col: col})
+			col += cell.ColSpan
+
+			if col > qtdCols {
+				qtdCols = col
+			}
+		}
+	}
+
+	return placed, qtdCols
+}
+
+// getBands return the end of each band, the rows which are merged by its cells. A row
+// without cells merged with the next rows is a band alone
+func (s *tableList) getBands(rows [][]tableCell) []int {
+	var bands []int
+
+	end := 0
+	for index, cells := range rows {
+		for _, cell := range cells {
+			if index+cell.RowSpan > end {
+				end = index + cell.RowSpan
+			}
+		}
+
+		if index+1 >= end {
+			end = index + 1
+			bands = append(bands, end)
+		}
+	}
+
+	return bands
+}
+
+// getHeights return the height of each row. When a merged cell doesn't fit in its rows, the last
+// one is enlarged
+func (s *tableList) getHeights(rows [][]tableCell, widths []float64, textProp props.Text) []float64 {
+	heights := make([]float64, len(rows))
+
+	for index, cells := range rows {
+		var texts []string
+		var cellWidths []float64
+
+		contentHeight := 0.0
+
+		for _, cell := range cells {
+			if cell.RowSpan > 1 {
+				continue
+			}
+
+			if cell.Content != nil || cell.style.Font != nil {
+				height := s.getCellHeight(cell, widths, textProp)
+				if height > contentHeight {
+					contentHeight = height
+				}
+
+				continue
+			}
+
+			texts = append(texts, cell.Text)
+			cellWidths = append(cellWidths, s.getCellWidth(cell, widths))
+		}
+
+		heights[index] = s.calcLinesHeight(texts, textProp, cellWidths)
+
+		// The row is as high as its tallest content
+		if contentHeight > heights[index] {
+			heights[index] = contentHeight
+		}
+	}
+
+	for _, cells := range rows {
+		for _, cell := range cells {
+			if cell.RowSpan == 1 {
+				continue
+			}
+
+			height := s.getCellHeight(cell, widths, textProp)
+			merged := s.sumHeights(heights, cell.row, cell.row+cell.RowSpan)
+
+			if height > merged {
+				heights[cell.row+cell.RowSpan-1] += height - merged
+			}
+		}
+	}
+
+	return heights
+}
+
+// getCellHeight return the height of the rows which fit a cell, with its content or its text
+func (s *tableList) getCellHeight(cell tableCell, widths []float64, textProp props.Text) float64 {
+	width := s.getCellWidth(cell, widths)
+
+	if cell.Content != nil {
+		return s.getContentHeight(cell.Content, width)
+	}
+
+	// Texts with its own font are measured with it
+	if cell.style.Font != nil {
+		return s.getTextHeight(cell.Text, s.getCellTextProp(textProp, cell.style), width)
+	}
+
+	return s.calcLinesHeight([]string{cell.Text}, textProp, []float64{width})
+}
+
+// sumHeights return the height of the rows from start until end
+func (s *tableList) sumHeights(heights []float64, start, end int) float64 {
+	sum := 0.0
+	for _, height := range heights[start:end] {
+		sum += height
+	}
+
+	return sum
+}
+
+// getTexts return the texts of the cells of a row, in the position of its columns
+func (s *tableList) getTexts(cells []tableCell, qtdCols int) []string {
+	texts := make([]string, qtdCols)
+	for _, cell := range cells {
+		texts[cell.col] = cell.Text
+	}
+
+	return texts
+}
+
+// getTableWidth return the width of all columns
+func (s *tableList) getTableWidth(widths []float64) float64 {
+	tableWidth := 0.0
+	for _, width := range widths {
+		tableWidth += width
+	}
+
+	return tableWidth
+}
+
+// getCellWidth return the width of the columns occupied by a cell
+func (s *tableList) getCellWidth(cell tableCell, widths []float64) float64 {
+	width := 0.0
+	for _, columnWidth := range widths[cell.col : cell.col+cell.ColSpan] {
+		width += columnWidth
+	}
+
+	return width
+}
+
+// getCellX return the distance between the left margin and a cell, right-to-left
+// tables starts at the right
+func (s *tableList) getCellX(cell tableCell, widths []float64, tableProp props.TableList) float64 {
+	x := 0.0
+	for _, columnWidth := range widths[:cell.col] {
+		x += columnWidth
+	}
+
+	if tableProp.Direction == consts.RightToLeft {
+		total := 0.0
+		for _, columnWidth := range widths {
+			total += columnWidth
+		}
+
+		return total - x - s.getCellWidth(cell, widths)
+	}
+
+	return x
+}
+
+// getColumnWidths return the width in mm of each column of the table. The space left by fixed and
+// auto-fit columns is shared by the proportional ones, which are at least minProportionalWidth for
+// each unit of its weight. When the columns don't fit in the page, all of them are narrowed
+func (s *tableList) getColumnWidths(qtdCols int, header, contents [][]tableCell, tableProp props.TableList,
+	headerTextProp, contentTextProp props.Text) []float64 {
+	available := s.getAvailableWidth()
+	widths := make([]float64, qtdCols)
+	weights := make([]float64, qtdCols)
+
+	measured := 0.0
+	totalWeight := 0.0
+
+	for index := 0; index < qtdCols; index++ {
+		columnWidth := props.ColumnWidth{Type: consts.Proportional, Value: 1.0}
+		if index < len(tableProp.ColumnWidths) {
+			columnWidth = tableProp.ColumnWidths[index]
+		}
+
+		switch columnWidth.Type {
+		case consts.Fixed:
+			widths[index] = columnWidth.Value
+		case consts.AutoFit:
+			widths[index] = s.getAutoFitWidth(index, columnWidth, header, contents, headerTextProp, contentTextProp)
+		default:
+			weights[index] = columnWidth.Value
+			totalWeight += columnWidth.Value
+			continue
+		}
+
+		measured += widths[index]
+	}
+
+	// Columns wider than the page keep its proportions, with the smallest proportional ones
+	reserved := totalWeight * minProportionalWidth
+	if measured+reserved > available {
+		for index := range widths {
+			widths[index] = (widths[index] + weights[index]*minProportionalWidth) * available / (measured + reserved)
+		}
+
+		return widths
+	}
+
+	for index, weight := range weights {
+		if weight > 0 {
+			widths[index] = (available - measured) * weight / totalWeight
+		}
+	}
+
+	return widths
+}
+
+// getAutoFitWidth return the width of the widest text of a column, with the space
+// between the text and the next column, limited by the Min and Max of the column.
+// Cells merged with the next columns aren't measured
+func (s *tableList) getAutoFitWidth(index int, columnWidth props.ColumnWidth, header, contents [][]tableCell,
+	headerTextProp, contentTextProp props.Text) float64 {
+	width := s.getWidestText(header, index, headerTextProp)
+
+	contentWidth := s.getWidestText(contents, index, contentTextProp)
+	if contentWidth > width {
+		width = contentWidth
+	}
+
+	width += autoFitPadding
+
+	if width < columnWidth.Min {
+		width = columnWidth.Min
+	}
+
+	if columnWidth.Max > 0 && width > columnWidth.Max {
+		width = columnWidth.Max
+	}
+
+	return width
+}
+
+// getWidestText return the width of the widest text of the cells placed only in a column,
+// without content
+func (s *tableList) getWidestText(rows [][]tableCell, index int, textProp props.Text) float64 {
+	width := 0.0
+
+	for _, cells := range rows {
+		for _, cell := range cells {
+			if cell.col != index || cell.ColSpan > 1 || cell.Content != nil {
+				continue
+			}
+
+			textWidth := s.text.GetStringWidth(cell.Text, textProp)
+			if textWidth > width {
+				width = textWidth
+			}
+		}
+	}
+
+	return width
+}
+
+// getAvailableWidth return the width between the page margins
+func (s *tableList) getAvailableWidth() float64 {
+	width, _ := s.pdf.GetPageSize()
+	left, _, right, _ := s.pdf.GetPageMargins()
+
+	return width - left - right
+}
+
+// inCell execute a closure with the margins moved to a cell, which starts at x from the left
+// margin, so texts and links added to one column fill only the cell
+func (s *tableList) inCell(x, width float64, closure func()) {
+	inCell(s.pdf, x, width, closure)
+}
+
+// resolveDirection find the direction of a table with consts.Auto in the texts of its header
+// and of its contents, and define the default align from it
+func (s *tableList) resolveDirection(headerGrid, contents [][]tableCell, tableProp props.TableList) props.TableList {
+	if tableProp.Direction != consts.Auto {
+		return tableProp
+	}
+
+	texts := []string{}
+	for _, rows := range [][][]tableCell{headerGrid, contents} {
+		for _, row := range rows {
+			for _, cell := range row {
+				texts = append(texts, cell.Text)
+			}
+		}
+	}
+
+	tableProp.Direction = s.bidi.GetDirection(strings.Join(texts, " "), consts.Auto)
+
+	if tableProp.Align == "" {
+		tableProp.Align = consts.Left
+		if tableProp.Direction == consts.RightToLeft {
+			tableProp.Align = consts.Right
+		}
+	}
+
+	return tableProp
+}
+
+// getAlign return the align of a column, CustomAlign when defined for it or Align otherwise
+func (s *tableList) getAlign(tableProp props.TableList, index int) consts.Align {
+	if index < len(tableProp.CustomAlign) {
+		return tableProp.CustomAlign[index]
+	}
+
+	return tableProp.Align
+}
+
+// getBackground return the background of a content row, rows without
+// an alternated background are white
+func (s *tableList) getBackground(tableProp props.TableList, index int) color.Color {
+	if index%2 == 0 && tableProp.AlternatedBackground != nil {
+		return *tableProp.AlternatedBackground
+	}
+
+	if index%2 == 1 && tableProp.AlternatedOddBackground != nil {
+		return *tableProp.AlternatedOddBackground
+	}
+
+	return color.NewWhite()
+}
+
+// getLink return the link of a content cell, cells without link have an empty one
+func (s *tableList) getLink(tableProp props.TableList, row int, col int) props.Link {
+	if row < len(tableProp.Links) && col < len(tableProp.Links[row]) {
+		return tableProp.Links[row][col]
+	}
+
+	return props.Link{}
+}
+
+func (s *tableList) calcLinesHeight(textList []string, textProp props.Text, widths []float64) float64 {
+	linesQuantities := []float64{}
+
+	for index, text := range textList {
+		if index >= len(widths) {
+			break
+		}
+
+		linesQuantities = append(linesQuantities, s.getLinesQuantity(text, textProp, widths[index]))
+	}
+
+	_, _, fontSize := s.font.GetFont()
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := fontSize / s.font.GetScaleFactor()
+	if textProp.LineHeight > 0 {
+		fontHeight = textProp.LineHeight
+	}
+
+	maxHeight := fontHeight * 2.0
+	for index, qtdLines := range linesQuantities {
+		height := fontHeight*qtdLines + s.getLinesExtra(textList[index], qtdLines, fontHeight, textProp)
+		if height > maxHeight {
+			maxHeight = height
+		}
+	}
+
+	return maxHeight + 3.0
+}
+
+// getLinesExtra return the space which the superscript and subscript parts of a text
+// need between its lines, a fixed LineHeight keeps the lines where they are
+func (s *tableList) getLinesExtra(text string, qtdLines, fontHeight float64, textProp props.Text) float64 {
+	if textProp.LineHeight > 0 {
+		return 0.0
+	}
+
+	return getRunsExtra(text, qtdLines, fontHeight)
+}
+
+// getLinesQuantity return the quantity of lines which a text occupy in a cell with the width
+func (s *tableList) getLinesQuantity(text string, textProp props.Text, width float64) float64 {
+	qtdLines := 0.0
+
+	s.inCell(0.0, width, func() {
+		qtdLines = float64(s.text.GetLinesQuantity(text, textProp, 1))
+	})
 
-	return fontHeight*maxLines + 3.0
+	return qtdLines
 }
@@ -1,6 +1,7 @@
 package internal_test
 
 import (
+	"errors"
 	"fmt"
 	"github.com/muhammadmuhlas/just_pdf/internal"
 	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
@@ -14,7 +15,7 @@ import (
 
 func TestNewTableList(t *testing.T) {
 	// Act
-	tableList := internal.NewTableList(nil, nil)
+	tableList := internal.NewTableList(nil, nil, nil, nil)
 
 	// Assert
 	assert.NotNil(t, tableList)
@@ -25,7 +26,7 @@ func TestTableList_Create_WhenHeaderIsNil(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
-	sut := internal.NewTableList(text, nil)
+	sut := internal.NewTableList(text, nil, nil, nil)
 
 	_, contents := getContents()
 
@@ -40,7 +41,7 @@ func TestTableList_Create_WhenHeaderIsEmpty(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
-	sut := internal.NewTableList(text, nil)
+	sut := internal.NewTableList(text, nil, nil, nil)
 
 	_, contents := getContents()
 
@@ -55,7 +56,7 @@ func TestTableList_Create_WhenContentIsNil(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
-	sut := internal.NewTableList(text, nil)
+	sut := internal.NewTableList(text, nil, nil, nil)
 
 	headers, _ := getContents()
 
@@ -70,7 +71,7 @@ func TestTableList_Create_WhenContentIsEmpty(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
-	sut := internal.NewTableList(text, nil)
+	sut := internal.NewTableList(text, nil, nil, nil)
 
 	headers, _ := getContents()
 
@@ -94,8 +95,11 @@ func TestTableList_Create_Happy(t *testing.T) {
 	justPdfGrid.On("Row", mock.Anything, mock.Anything).Return(nil)
 	justPdfGrid.On("Line", mock.Anything).Return(nil)
 	justPdfGrid.On("SetBackgroundColor", mock.Anything).Return(nil)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
 
-	sut := internal.NewTableList(text, font)
+	sut := internal.NewTableList(text, font, nil, nil)
 	sut.BindGrid(justPdfGrid)
 
 	headers, contents := getContents()
@@ -118,6 +122,49 @@ func TestTableList_Create_Happy(t *testing.T) {
 	justPdfGrid.AssertNotCalled(t, "SetBackgroundColor")
 }
 
+func TestTableList_Create_WhenPropsAreNotDefined(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	headers, contents := getContents()
+
+	// Act
+	sut.Create(headers, contents)
+
+	// Assert
+	// The columns without CustomAlign use Align and the rows without background are white
+	text.AssertCalled(t, "Add", "j = 0", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Align == consts.Left
+	}), mock.Anything, 0.0, mock.Anything)
+	justPdfGrid.AssertCalled(t, "SetBackgroundColor", color.NewWhite())
+	text.AssertCalled(t, "Add", "i = 0, j = 0", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Color == color.NewBlack()
+	}), mock.Anything, 0.0, mock.Anything)
+}
+
 func TestTableList_Create_HappyWithBackgroundColor(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
@@ -131,8 +178,11 @@ func TestTableList_Create_HappyWithBackgroundColor(t *testing.T) {
 	justPdfGrid.On("Row", mock.Anything, mock.Anything).Return(nil)
 	justPdfGrid.On("Line", mock.Anything).Return(nil)
 	justPdfGrid.On("SetBackgroundColor", mock.Anything).Return(nil)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
 
-	sut := internal.NewTableList(text, font)
+	sut := internal.NewTableList(text, font, nil, nil)
 	sut.BindGrid(justPdfGrid)
 
 	headers, contents := getContents()
@@ -176,8 +226,11 @@ func TestTableList_Create_Happy_Without_Line(t *testing.T) {
 	justPdfGrid.On("Row", mock.Anything, mock.Anything).Return(nil)
 	justPdfGrid.On("Line", mock.Anything).Return(nil)
 	justPdfGrid.On("SetBackgroundColor", mock.Anything).Return(nil)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
 
-	sut := internal.NewTableList(text, font)
+	sut := internal.NewTableList(text, font, nil, nil)
 	sut.BindGrid(justPdfGrid)
 
 	headers, contents := getContents()
@@ -202,7 +255,7 @@ func TestTableList_Create_WhenContentIsEmptyWithLine(t *testing.T) {
 	// Arrange
 	text := &mocks.Text{}
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
-	sut := internal.NewTableList(text, nil)
+	sut := internal.NewTableList(text, nil, nil, nil)
 
 	justPdfGrid := mocks.JustPdf{}
 	justPdfGrid.On("Line", mock.Anything).Return(nil)
@@ -219,6 +272,866 @@ func TestTableList_Create_WhenContentIsEmptyWithLine(t *testing.T) {
 	justPdfGrid.AssertNotCalled(t, "Line")
 }
 
+func TestTableList_Create_WhenColumnWidths(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("GetStringWidth", mock.Anything, mock.Anything).Return(func(value string, textProp props.Text) float64 {
+		return float64(len(value)) * 2.0
+	})
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	headers, contents := getContents()
+
+	// Act
+	sut.Create(headers, contents[:1], props.TableList{
+		ColumnWidths: []props.ColumnWidth{
+			{Type: consts.Fixed, Value: 20.0},
+			{Type: consts.AutoFit, Min: 10.0, Max: 50.0},
+			{Type: consts.Proportional, Value: 3.0},
+		},
+	})
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 8)
+
+	// Fixed column with 20mm
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 10.0, 180.0)
+	// Auto-fit column measured from "i = 0, j = 1" with the padding
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 30.0, 154.0)
+	// Proportional columns share the 144mm left, the last one has weight 1
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 56.0, 46.0)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 164.0, 10.0)
+}
+
+func TestTableList_Create_WhenColumnWidthsDontFit(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	headers, contents := getContents()
+
+	// Act
+	sut.Create(headers, contents[:1], props.TableList{
+		ColumnWidths: []props.ColumnWidth{
+			{Type: consts.Fixed, Value: 200.0},
+			{Type: consts.Fixed, Value: 160.0},
+		},
+	})
+
+	// Assert
+	// The 380mm, with 10mm of each proportional column, are narrowed to the 190mm of the page
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 10.0, 100.0)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 110.0, 20.0)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 190.0, 15.0)
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 195.0, 10.0)
+}
+
+func TestTableList_Create_WhenDirectionIsAuto(t *testing.T) {
+	// Arrange
+	left := 0.0
+	lefts := map[string]float64{}
+
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		lefts[args.String(0)] = left
+	})
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		left = args.Get(0).(float64)
+	})
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	// Act
+	sut.Create([]string{"שם", "מחיר"}, [][]string{{"קפה", "10"}}, props.TableList{Direction: consts.Auto})
+
+	// Assert
+	// The direction is found in the header, so the first column is drawn at the right
+	assert.Equal(t, 105.0, lefts["שם"])
+	assert.Equal(t, 10.0, lefts["מחיר"])
+	assert.Equal(t, 105.0, lefts["קפה"])
+	text.AssertCalled(t, "Add", "שם", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Align == consts.Right && prop.Direction == consts.RightToLeft
+	}), mock.Anything, 0.0, 1.0)
+}
+
+func TestTableList_Create_WhenRepeatHeader(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	var repeatedHeader func()
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("RegisterRepeatedHeader", mock.Anything).Run(func(args mock.Arguments) {
+		if closure, ok := args.Get(0).(func()); ok && closure != nil {
+			repeatedHeader = closure
+		}
+	})
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	headers, contents := getContents()
+
+	// Act
+	sut.Create(headers, contents[:1], props.TableList{
+		RepeatHeader:     true,
+		ContinuedCaption: "(continued)",
+	})
+
+	repeatedHeader()
+
+	// Assert
+	justPdfGrid.AssertNumberOfCalls(t, "RegisterRepeatedHeader", 2)
+	justPdfGrid.AssertCalled(t, "RegisterRepeatedHeader", (func())(nil))
+	// Header, content, caption and the repeated header
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 4)
+	text.AssertCalled(t, "Add", "(continued)", mock.Anything, 1.0, 0.0, 1.0)
+	text.AssertNumberOfCalls(t, "Add", 13)
+}
+
+func TestTableList_CreateCells_WhenMergedCells(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("DrawLine", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	justPdfGrid.On("Line", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{
+		{{Text: "Product", RowSpan: 2}, {Text: "Q1", ColSpan: 3}},
+		{{Text: "Jan"}, {Text: "Feb"}, {Text: "Mar"}},
+	}
+	contents := [][]props.TableCell{
+		{{Text: "Coffee", RowSpan: 2}, {Text: "1"}, {Text: "2"}, {Text: "3"}},
+		{{Text: "4"}, {Text: "5"}, {Text: "6"}},
+		{{Text: "Tea"}, {Text: "7"}, {Text: "8"}, {Text: "9"}, {Text: "discarded"}},
+	}
+
+	rowHeight := 1.0/1.5*2.0 + 3.0
+
+	// Act
+	sut.CreateCells(header, contents, props.TableList{Line: true})
+
+	// Assert
+	// Header with its two rows, Coffee with its two rows and Tea
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 3)
+	justPdfGrid.AssertCalled(t, "Row", rowHeight*2.0, mock.Anything)
+	justPdfGrid.AssertCalled(t, "Row", rowHeight, mock.Anything)
+	justPdfGrid.AssertNumberOfCalls(t, "Line", 2)
+	text.AssertNumberOfCalls(t, "Add", 16)
+	text.AssertNotCalled(t, "Add", "discarded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Q1 above Jan, Feb and Mar
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 57.5, 10.0)
+	// 4 is placed after the merged Coffee
+	text.AssertCalled(t, "Add", "4", mock.Anything, rowHeight+0.7+2.0, 0.0, 1.0)
+
+	// Lines only below 1, 2 and 3, Coffee is merged with the next row
+	justPdfGrid.AssertNumberOfCalls(t, "DrawLine", 3)
+	justPdfGrid.AssertCalled(t, "DrawLine", 47.5, rowHeight, 95.0, rowHeight)
+	justPdfGrid.AssertNotCalled(t, "DrawLine", 0.0, rowHeight, 47.5, rowHeight)
+}
+
+func TestTableList_Create_WhenFooter(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	var repeatedHeader func()
+	var repeatedFooter func()
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("RegisterRepeatedHeader", mock.Anything).Run(func(args mock.Arguments) {
+		if closure, ok := args.Get(0).(func()); ok && closure != nil {
+			repeatedHeader = closure
+		}
+	})
+	justPdfGrid.On("RegisterRepeatedFooter", mock.Anything).Run(func(args mock.Arguments) {
+		if closure, ok := args.Get(0).(func()); ok && closure != nil {
+			repeatedFooter = closure
+		}
+	})
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	headers := []string{"Item", "Quantity", "Price", "Discount"}
+	contents := [][]string{
+		{"Espresso", "2", "2.50", ""},
+		{"Cappuccino", "5", "3.00", "0.50"},
+		{"Tea", "not a number", "1.75", ""},
+	}
+
+	// Act
+	sut.Create(headers, contents, props.TableList{
+		Footer: &props.TableFooter{
+			Cells: []props.FooterCell{
+				{Text: "Total"},
+				{Aggregation: consts.Sum},
+				{Aggregation: consts.Average},
+				{Aggregation: consts.Count},
+			},
+			CarriedForward: true,
+		},
+	})
+
+	repeatedFooter()
+	repeatedHeader()
+
+	// Assert
+	justPdfGrid.AssertCalled(t, "RegisterRepeatedHeader", (func())(nil))
+	justPdfGrid.AssertCalled(t, "RegisterRepeatedFooter", (func())(nil))
+	// Header, 3 contents, footer, carried forward and brought forward
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 7)
+
+	text.AssertCalled(t, "Add", "Total", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Carried forward", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Brought forward", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "7.00", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "2.42", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "1", mock.Anything, mock.Anything, 0.0, 1.0)
+}
+
+func TestTableList_CreateCells_WhenContent(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	image := &mocks.Image{}
+	image.On("AddFromBase64", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil)
+
+	code := &mocks.Code{}
+	code.On("AddBar", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	code.On("AddQr", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("DrawLine", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	sut := internal.NewTableList(text, font, image, code)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{
+		{{Text: "Thumbnail"}, {Text: "SKU"}, {Text: "Link"}, {Text: "Packed"}, {Text: "Name"}},
+	}
+	contents := [][]props.TableCell{
+		{
+			{Content: props.ImageCell{Base64: "base64", Extension: consts.Png, Height: 30.0}},
+			{Content: props.BarcodeCell{Code: "123456"}},
+			{Content: props.QrCodeCell{Code: "https://example.com"}},
+			{Content: props.CheckboxCell{Checked: true}},
+			{Content: props.TextCell{Text: "Coffee", Prop: props.Text{Style: consts.Bold}}},
+		},
+	}
+
+	// Act
+	err := sut.CreateCells(header, contents)
+
+	// Assert
+	assert.Nil(t, err)
+
+	// The row is as high as the image, with the padding
+	justPdfGrid.AssertCalled(t, "Row", 32.0, mock.Anything)
+
+	image.AssertCalled(t, "AddFromBase64", "base64", 1.0, 0.0, 1.0, 30.0, mock.Anything, consts.Png)
+	code.AssertCalled(t, "AddBar", "123456", 1.0, 0.0, 1.0, 10.0, mock.Anything)
+	code.AssertCalled(t, "AddQr", "https://example.com", 1.0, 0.0, 1.0, 20.0, mock.Anything)
+
+	// The box and the check mark of the checkbox
+	justPdfGrid.AssertNumberOfCalls(t, "DrawLine", 4)
+	justPdfGrid.AssertCalled(t, "DrawLine", 115.0, 1.0, 119.0, 1.0)
+	text.AssertCalled(t, "Add", "4", mock.Anything, 1.0+4.0*0.8, 0.0, 1.0)
+
+	text.AssertCalled(t, "Add", "Coffee", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertNotCalled(t, "GetStringWidth", mock.Anything, mock.Anything)
+}
+
+func TestTableList_CreateCells_WhenContentCantBeAdded(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	image := &mocks.Image{}
+	image.On("AddFromBase64", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(errors.New("invalid image"))
+
+	code := &mocks.Code{}
+	code.On("AddBar", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("invalid code"))
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, image, code)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{{{Text: "Thumbnail"}, {Text: "SKU"}, {Text: "Name"}}}
+	contents := [][]props.TableCell{
+		{
+			{Content: props.ImageCell{Base64: "invalid", Extension: consts.Png}},
+			{Content: props.BarcodeCell{Code: "invalid"}},
+			{Text: "Coffee"},
+		},
+	}
+
+	// Act
+	err := sut.CreateCells(header, contents)
+
+	// Assert
+	// The whole table is added, with the first error
+	assert.Equal(t, err, errors.New("invalid image"))
+	code.AssertNumberOfCalls(t, "AddBar", 1)
+	text.AssertCalled(t, "Add", "Coffee", mock.Anything, mock.Anything, 0.0, 1.0)
+}
+
+func TestTableList_CreateCells_WhenSuperscripts(t *testing.T) {
+	// Arrange
+	noted := "Revenue" + internal.Superscript("1") + " grew" + internal.Superscript("2")
+
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", noted, mock.Anything, mock.Anything).Return(3)
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Normal, 10.0)
+	font.On("GetScaleFactor").Return(1.0)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{{{Text: "Name"}}}
+	contents := [][]props.TableCell{{{Text: noted}}}
+
+	// Act
+	sut.CreateCells(header, contents)
+
+	// Assert
+	// Each superscript can raise one of the lines after the first
+	justPdfGrid.AssertCalled(t, "Row", 23.0, mock.Anything)
+	justPdfGrid.AssertCalled(t, "Row", 10.0*3.0+2.0*10.0*0.35+3.0, mock.Anything)
+}
+
+func TestTableList_CreateCells_WhenSubscripts(t *testing.T) {
+	// Arrange
+	formula := "H" + internal.Subscript("2") + "O and CO" + internal.Subscript("2")
+
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", formula, mock.Anything, mock.Anything).Return(2)
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Normal, 10.0)
+	font.On("GetScaleFactor").Return(1.0)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{{{Text: "Name"}}}
+	contents := [][]props.TableCell{{{Text: formula}}}
+
+	// Act
+	sut.CreateCells(header, contents)
+
+	// Assert
+	// Each subscript can lower one of the lines
+	justPdfGrid.AssertCalled(t, "Row", 10.0*2.0+2.0*10.0*0.2+3.0, mock.Anything)
+}
+
+func TestTableList_CreateCells_WhenRotatedText(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.On("AddRotated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.On("GetRotatedHeight", "Quantity", mock.Anything, 1.0).Return(25.0)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	header := [][]props.TableCell{
+		{{Text: "Name"}, {Text: "Total"}},
+	}
+	contents := [][]props.TableCell{
+		{
+			{Text: "Coffee"},
+			{Content: props.TextCell{Text: "Quantity", Prop: props.Text{Size: 10.0, Rotation: 90}}},
+		},
+	}
+
+	// Act
+	sut.CreateCells(header, contents)
+
+	// Assert
+	// The row is as high as the rotated text, with the padding, and the text is fitted inside it
+	justPdfGrid.AssertCalled(t, "Row", 27.0, mock.Anything)
+	text.AssertCalled(t, "AddRotated", "Quantity", mock.Anything, 1.0, 0.0, 1.0, 25.0)
+}
+
+func TestTableList_Create_WhenStyles(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("FillRect", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	justPdfGrid.On("DrawLine", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	red := color.Color{Red: 255}
+	gray := color.Color{Red: 200, Green: 200, Blue: 200}
+	border := props.Line{Width: 0.5}
+
+	header := []string{"Product", "Balance"}
+	contents := [][]string{
+		{"Coffee", "10"},
+		{"Tea", "-5"},
+		{"Milk", "abc"},
+	}
+
+	// Act
+	sut.Create(header, contents, props.TableList{
+		RowStyles: []props.CellStyle{{BackgroundColor: &gray}},
+		StyleRules: []props.StyleRule{
+			{Column: 1, Operator: consts.LessThan, Value: "0", Style: props.CellStyle{TextColor: &red}},
+		},
+		CellStyles: [][]props.CellStyle{nil, nil, {{Border: &border}}},
+	})
+
+	// Assert
+	// Both cells of the first row have the background of the row
+	justPdfGrid.AssertNumberOfCalls(t, "FillRect", 2)
+	justPdfGrid.AssertCalled(t, "FillRect", 0.0, 0.0, 95.0, mock.Anything, gray)
+
+	// Only the negative number is red, the text which isn't a number never matches
+	text.AssertCalled(t, "Add", "-5", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Color == red
+	}), mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "abc", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Color == color.NewBlack()
+	}), mock.Anything, 0.0, 1.0)
+
+	// The border around the first cell of the last row
+	justPdfGrid.AssertNumberOfCalls(t, "DrawLine", 4)
+	justPdfGrid.AssertCalled(t, "DrawLine", 0.0, 0.0, 95.0, 0.0, border)
+}
+
+func TestTableList_Create_WhenBorder(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("Line", mock.Anything)
+	justPdfGrid.On("RegisterRepeatedHeader", mock.Anything)
+	justPdfGrid.On("RegisterRepeatedFooter", mock.Anything)
+	justPdfGrid.On("DrawLine", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	red := props.Line{Width: 1.0, Color: color.Color{Red: 255}}
+
+	header := []string{"Product", "Price"}
+	contents := [][]string{
+		{"Coffee", "2.50"},
+		{"Tea", "1.50"},
+	}
+
+	// Act
+	sut.Create(header, contents, props.TableList{
+		Border:  consts.FullGrid,
+		Borders: &props.Borders{Left: &red},
+		Line:    true,
+	})
+
+	// Assert
+	// The header and each row have a line between the columns, a line
+	// below them and the sides, the header has the top and after the
+	// rows there is the bottom of the table
+	justPdfGrid.AssertNumberOfCalls(t, "DrawLine", 14)
+	justPdfGrid.AssertCalled(t, "DrawLine", 0.0, 0.0, 190.0, 0.0, props.Line{Width: 0.2})
+	justPdfGrid.AssertCalled(t, "DrawLine", 95.0, 0.0, 95.0, mock.Anything, props.Line{Width: 0.2})
+	justPdfGrid.AssertCalled(t, "DrawLine", 0.0, 0.0, 0.0, mock.Anything, red)
+	justPdfGrid.AssertCalled(t, "Row", 0.0, mock.Anything)
+
+	// The top and the bottom of the table are drawn again around the page breaks
+	justPdfGrid.AssertCalled(t, "RegisterRepeatedHeader", mock.Anything)
+	justPdfGrid.AssertCalled(t, "RegisterRepeatedFooter", mock.Anything)
+
+	// The lines of Line aren't drawn in tables with borders
+	justPdfGrid.AssertNotCalled(t, "Line", mock.Anything)
+}
+
+func TestTableList_CreateFromIterator(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	records := [][]string{
+		{"Product", "Price"},
+		{"Coffee", "2.50"},
+		{"Tea", "1.50"},
+	}
+
+	// The Row of each content is drawn before the next content is read
+	drawnRows := []int{}
+	next := func() ([]string, bool) {
+		drawnRows = append(drawnRows, len(justPdfGrid.Calls))
+		if len(records) == 0 {
+			return nil, false
+		}
+
+		record := records[0]
+		records = records[1:]
+		return record, true
+	}
+
+	// Act
+	sut.CreateFromIterator(nil, next, props.TableList{
+		Links: [][]props.Link{nil, {{URL: "https://example.com/tea"}}},
+	})
+
+	// Assert
+	// The header and one Row to each content
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 3)
+	text.AssertCalled(t, "Add", "Product", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Tea", mock.Anything, mock.Anything, 0.0, 1.0)
+
+	// The links are found by the position of the content in all contents
+	justPdfGrid.AssertCalled(t, "Link", props.Link{URL: "https://example.com/tea"})
+
+	assert.Equal(t, 4, len(drawnRows))
+	assert.True(t, drawnRows[2] < drawnRows[3])
+}
+
+func TestTableList_Create_WhenGroup(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	calls := []string{}
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("KeepWithNext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		calls = append(calls, "KeepWithNext")
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("AddPage").Run(func(args mock.Arguments) {
+		calls = append(calls, "AddPage")
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	header := []string{"Region", "Amount"}
+	contents := [][]string{
+		{"North", "1"},
+		{"North", "2"},
+		{"South", "5"},
+	}
+
+	// Act
+	sut.Create(header, contents, props.TableList{
+		Group: &props.TableGroup{
+			Header: func(key string) string {
+				return "Region " + key
+			},
+			Footer: func(key string) []string {
+				return []string{"Subtotal " + key}
+			},
+			Subtotals: []props.FooterCell{{}, {Aggregation: consts.Sum}},
+			PageBreak: true,
+		},
+	})
+
+	// Assert
+	// Each group has a header, which is kept with its first content, and a footer, the
+	// second group starts in a new page
+	assert.Equal(t, []string{"KeepWithNext", "AddPage", "KeepWithNext"}, calls)
+	justPdfGrid.AssertNumberOfCalls(t, "Row", 8)
+
+	text.AssertCalled(t, "Add", "Region North", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Region South", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Subtotal North", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "3.00", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Subtotal South", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "5.00", mock.Anything, mock.Anything, 0.0, 1.0)
+}
+
 func getContents() ([]string, [][]string) {
 	header := []string{"j = 0", "j = 1", "j = 2", "j = 4"}
 
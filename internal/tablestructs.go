@@ -0,0 +1,266 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"github.com/muhammadmuhlas/just_pdf/pkg/props"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultTimeLayout is the layout of the time.Time fields without format
+const defaultTimeLayout = "2006-01-02"
+
+var timeType = reflect.TypeOf(time.Time{})
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// structColumn is a column of a table created from structs, read from a field and its tag
+type structColumn struct {
+	// index of the field, with the indexes of its parents when it is nested
+	index  []int
+	header string
+	align  consts.Align
+	format string
+	width  float64
+}
+
+// CreateFromStructs create a TableList with a column to each exported field of the structs, the fields
+// of nested structs are columns too. The columns are changed by the tag "pdf", ex:
+// `pdf:"header=Amount,align=R,format=%.2f,width=20"`, and the fields with `pdf:"-"` are skipped
+func (s *tableList) CreateFromStructs(slice interface{}, prop ...props.TableList) error {
+	value := reflect.ValueOf(slice)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return errors.New("Could not create table, the value isn't a slice of structs")
+	}
+
+	structType := value.Type().Elem()
+	for structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+
+	if structType.Kind() != reflect.Struct {
+		return errors.New("Could not create table, the value isn't a slice of structs")
+	}
+
+	columns := s.getStructColumns(structType, nil, "")
+	if len(columns) == 0 {
+		return nil
+	}
+
+	header := make([]string, len(columns))
+	for index, column := range columns {
+		header[index] = column.header
+	}
+
+	contents := make([][]string, value.Len())
+	for row := range contents {
+		contents[row] = make([]string, len(columns))
+		for index, column := range columns {
+			contents[row][index] = s.formatField(value.Index(row), column)
+		}
+	}
+
+	tableProp := props.TableList{}
+
+	if len(prop) > 0 {
+		tableProp = prop[0]
+	}
+
+	// The default align is known after MakeValid
+	tableProp.MakeValid()
+
+	tableProp.CustomAlign = s.getStructAligns(columns, tableProp)
+	tableProp.ColumnWidths = s.getStructWidths(columns, tableProp)
+
+	s.Create(header, contents, tableProp)
+
+	return nil
+}
+
+// getStructColumns return the columns of the exported fields of a struct, nested structs
+// are replaced by its fields, with the header of the parent before the header of the field
+func (s *tableList) getStructColumns(structType reflect.Type, parent []int, prefix string) []structColumn {
+	columns := []structColumn{}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("pdf")
+
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+
+		index := make([]int, len(parent), len(parent)+1)
+		copy(index, parent)
+		index = append(index, i)
+
+		column := s.parseStructTag(tag)
+		column.index = index
+
+		if column.header == "" {
+			column.header = field.Name
+			if prefix != "" {
+				column.header = prefix + " " + field.Name
+			}
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if s.isNestedStruct(fieldType) {
+			// The fields of embedded structs are columns like the fields of its parent
+			nestedPrefix := column.header
+			if field.Anonymous {
+				nestedPrefix = prefix
+			}
+
+			columns = append(columns, s.getStructColumns(fieldType, index, nestedPrefix)...)
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+// isNestedStruct return if the fields of a struct are columns, time.Time
+// and fmt.Stringer are written in only one column
+func (s *tableList) isNestedStruct(fieldType reflect.Type) bool {
+	if fieldType.Kind() != reflect.Struct || fieldType == timeType {
+		return false
+	}
+
+	return !fieldType.Implements(stringerType) && !reflect.PtrTo(fieldType).Implements(stringerType)
+}
+
+// parseStructTag return the column defined by a tag, ex: "header=Amount,align=R,format=%.2f,width=20"
+func (s *tableList) parseStructTag(tag string) structColumn {
+	column := structColumn{}
+
+	for _, option := range strings.Split(tag, ",") {
+		pair := strings.SplitN(option, "=", 2)
+		if len(pair) != 2 {
+			continue
+		}
+
+		key := strings.TrimSpace(pair[0])
+		value := strings.TrimSpace(pair[1])
+
+		switch key {
+		case "header":
+			column.header = value
+		case "align":
+			column.align = consts.Align(strings.ToUpper(value))
+		case "format":
+			column.format = value
+		case "width":
+			if width, err := strconv.ParseFloat(value, 64); err == nil {
+				column.width = width
+			}
+		}
+	}
+
+	return column
+}
+
+// formatField return the text of the field of a column, nil pointers are empty texts
+func (s *tableList) formatField(value reflect.Value, column structColumn) string {
+	for _, index := range column.index {
+		for value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return ""
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(index)
+	}
+
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+
+		value = value.Elem()
+	}
+
+	// The fields of unexported embedded structs can't be read as interfaces
+	if !value.CanInterface() {
+		return fmt.Sprint(value)
+	}
+
+	if value.Type() == timeType {
+		layout := column.format
+		if layout == "" {
+			layout = defaultTimeLayout
+		}
+
+		return value.Interface().(time.Time).Format(layout)
+	}
+
+	item := value.Interface()
+
+	// The String with pointer receiver is found from the address of the field
+	if value.CanAddr() {
+		if stringer, ok := value.Addr().Interface().(fmt.Stringer); ok {
+			item = stringer
+		}
+	}
+
+	if column.format != "" {
+		return fmt.Sprintf(column.format, item)
+	}
+
+	return fmt.Sprint(item)
+}
+
+// getStructAligns return the align of each column, the aligns defined by CustomAlign override the tags
+func (s *tableList) getStructAligns(columns []structColumn, tableProp props.TableList) []consts.Align {
+	aligns := make([]consts.Align, len(columns))
+
+	for index, column := range columns {
+		switch {
+		case index < len(tableProp.CustomAlign):
+			aligns[index] = tableProp.CustomAlign[index]
+		case column.align != "":
+			aligns[index] = column.align
+		default:
+			aligns[index] = tableProp.Align
+		}
+	}
+
+	return aligns
+}
+
+// getStructWidths return the width of each column, the widths defined by ColumnWidths override the tags
+func (s *tableList) getStructWidths(columns []structColumn, tableProp props.TableList) []props.ColumnWidth {
+	widths := make([]props.ColumnWidth, len(columns))
+
+	for index, column := range columns {
+		switch {
+		case index < len(tableProp.ColumnWidths):
+			widths[index] = tableProp.ColumnWidths[index]
+		case column.width > 0.0:
+			widths[index] = props.ColumnWidth{Type: consts.Fixed, Value: column.width}
+		default:
+			widths[index] = props.ColumnWidth{Type: consts.Proportional, Value: 1.0}
+		}
+	}
+
+	return widths
+}
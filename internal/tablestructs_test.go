@@ -0,0 +1,118 @@
+package internal_test
+
+import (
+	"github.com/muhammadmuhlas/just_pdf/internal"
+	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
+	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
+	"github.com/muhammadmuhlas/just_pdf/pkg/props"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type invoiceStatus int
+
+func (s invoiceStatus) String() string {
+	if s == 1 {
+		return "Paid"
+	}
+
+	return "Open"
+}
+
+type invoiceCustomer struct {
+	Name string
+	City string `pdf:"header=Town"`
+}
+
+type invoice struct {
+	Number   string `pdf:"header=No.,width=20"`
+	Customer invoiceCustomer
+	Amount   float64 `pdf:"header=Amount,align=R,format=%.2f"`
+	Status   invoiceStatus
+	Due      time.Time `pdf:"format=02/01/2006"`
+	Note     *string
+	Internal string `pdf:"-"`
+	secret   string
+}
+
+func TestTableList_CreateFromStructs(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	font := &mocks.Font{}
+	font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
+	font.On("GetScaleFactor").Return(1.5)
+
+	justPdfGrid := &mocks.JustPdf{}
+	justPdfGrid.On("Row", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(func())()
+	})
+	justPdfGrid.On("Col", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(func())()
+	})
+	justPdfGrid.On("SetBackgroundColor", mock.Anything)
+	justPdfGrid.On("GetCurrentOffset").Return(0.0)
+	justPdfGrid.On("GetPageSize").Return(210.0, 297.0)
+	justPdfGrid.On("GetPageMargins").Return(10.0, 10.0, 10.0, 10.0)
+	justPdfGrid.On("SetLRMargins", mock.Anything, mock.Anything)
+	justPdfGrid.On("Link", mock.Anything)
+	justPdfGrid.On("Line", mock.Anything)
+
+	sut := internal.NewTableList(text, font, nil, nil)
+	sut.BindGrid(justPdfGrid)
+
+	note := "Urgent"
+	invoices := []invoice{
+		{
+			Number:   "A-1",
+			Customer: invoiceCustomer{Name: "Alice", City: "Lisbon"},
+			Amount:   12.5,
+			Status:   1,
+			Due:      time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC),
+			Note:     &note,
+			Internal: "hidden",
+			secret:   "hidden",
+		},
+	}
+
+	// Act
+	err := sut.CreateFromStructs(invoices)
+
+	// Assert
+	assert.Nil(t, err)
+
+	// The nested fields without header have the header of its parent
+	for _, header := range []string{"No.", "Customer Name", "Town", "Amount", "Status", "Due", "Note"} {
+		text.AssertCalled(t, "Add", header, mock.Anything, mock.Anything, 0.0, 1.0)
+	}
+
+	for _, content := range []string{"A-1", "Alice", "Lisbon", "12.50", "Paid", "15/03/2020", "Urgent"} {
+		text.AssertCalled(t, "Add", content, mock.Anything, mock.Anything, 0.0, 1.0)
+	}
+
+	text.AssertCalled(t, "Add", "12.50", mock.MatchedBy(func(prop props.Text) bool {
+		return prop.Align == consts.Right
+	}), mock.Anything, 0.0, 1.0)
+	text.AssertNotCalled(t, "Add", "hidden", mock.Anything, mock.Anything, 0.0, 1.0)
+	text.AssertNotCalled(t, "Add", "Internal", mock.Anything, mock.Anything, 0.0, 1.0)
+
+	// The fixed width of the first column
+	justPdfGrid.AssertCalled(t, "SetLRMargins", 10.0, 180.0)
+}
+
+func TestTableList_CreateFromStructs_WhenNotSliceOfStructs(t *testing.T) {
+	// Arrange
+	sut := internal.NewTableList(nil, nil, nil, nil)
+
+	// Act
+	errNotSlice := sut.CreateFromStructs(invoice{})
+	errNotStructs := sut.CreateFromStructs([]string{"A-1"})
+
+	// Assert
+	assert.NotNil(t, errNotSlice)
+	assert.NotNil(t, errNotStructs)
+}
@@ -1,22 +1,96 @@
 package internal
 
 import (
+	gomath "math"
+
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
 	"github.com/jung-kurt/gofpdf"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Text is the abstraction which deals of how to add text inside PDF
 type Text interface {
 	Add(text string, fontFamily props.Text, marginTop float64, actualCol float64, qtdCols float64)
+	AddRotated(text string, fontFamily props.Text, marginTop float64, actualCol float64, qtdCols float64, colHeight float64)
 	GetLinesQuantity(text string, fontFamily props.Text, qtdCols float64) int
+	GetFitSize(text string, fontFamily props.Text, qtdCols float64, height float64) float64
+	GetStringWidth(text string, fontFamily props.Text) float64
+	GetLinesHeight(text string, fontFamily props.Text, qtdCols float64) float64
+	GetRotatedHeight(text string, fontFamily props.Text, qtdCols float64) float64
+}
+
+const (
+	// ellipsis is appended to a text cut by MaxLines or by the cell boundary
+	ellipsis = "..."
+	// fitSizeStep is how much the font size is reduced in each ShrinkToFit attempt
+	fitSizeStep = 0.5
+	// superscriptMark starts and ends a superscript part of a text, the order of
+	// the marks doesn't matter, so they survive the bidi reordering
+	superscriptMark = "\x02"
+	// subscriptMark starts and ends a subscript part of a text, like superscriptMark
+	subscriptMark = "\x03"
+	// smallCapsMark starts and ends a small caps part of a text, like superscriptMark
+	smallCapsMark = "\x04"
+	// runMarks are all the marks which start and end a part of a text
+	runMarks = superscriptMark + subscriptMark + smallCapsMark
+	// superscriptScale is the size of a superscript part, relative to the font size
+	superscriptScale = 0.6
+	// superscriptRise is how much a superscript part is raised, relative to the font height
+	superscriptRise = 0.35
+	// subscriptScale is the size of a subscript part, relative to the font size
+	subscriptScale = 0.6
+	// subscriptDrop is how much a subscript part is lowered, relative to the font height
+	subscriptDrop = 0.2
+	// smallCapsScale is the size of the lower case letters of a small caps part, relative to the font size
+	smallCapsScale = 0.75
+	// tab separates the parts of a text which are aligned to the tab stops
+	tab = "\t"
+	// rotationEpsilon is the smallest sine or cosine of a rotation which limits the lines of a text
+	rotationEpsilon = 1e-9
+)
+
+// run is a part of a line written with the same size and baseline, marked by one of the runMarks
+type run struct {
+	text string
+	mark string
 }
 
 type text struct {
 	pdf  gofpdf.Pdf
 	math Math
 	font Font
+	bidi Bidi
+}
+
+// Superscript mark a part of a text to be written smaller and raised, ex: a footnote reference
+func Superscript(text string) string {
+	return superscriptMark + text + superscriptMark
+}
+
+// Subscript mark a part of a text to be written smaller and lowered, ex: the 2 of H2O
+func Subscript(text string) string {
+	return subscriptMark + text + subscriptMark
+}
+
+// SmallCaps mark a part of a text to have its lower case letters written as smaller capitals
+func SmallCaps(text string) string {
+	return smallCapsMark + text + smallCapsMark
+}
+
+// RemoveRunMarks return a text without the marks of its superscript, subscript and small caps
+// parts, to be used where they can't be written, ex: the outline of the PDF viewers
+func RemoveRunMarks(text string) string {
+	return strings.Map(func(char rune) rune {
+		if strings.ContainsRune(runMarks, char) {
+			return -1
+		}
+
+		return char
+	}, text)
 }
 
 // NewText create a Text
@@ -25,6 +99,7 @@ func NewText(pdf gofpdf.Pdf, math Math, font Font) *text {
 		pdf,
 		math,
 		font,
+		NewBidi(),
 	}
 }
 
@@ -32,57 +107,389 @@ func NewText(pdf gofpdf.Pdf, math Math, font Font) *text {
 func (s *text) Add(text string, textProp props.Text, marginTop float64, actualCol float64, qtdCols float64) {
 	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
 
-	translator := s.pdf.UnicodeTranslatorFromDescriptor("")
-	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
-	s.pdf.SetTextColor(textProp.Color.Red, textProp.Color.Green, textProp.Color.Blue)
+	textTranslated, textProp := s.prepare(text, textProp)
 
-	// Apply Unicode
-	textTranslated := translator(text)
+	// The first line of a rotated text starts at the baseline, the height of the cell isn't limited
+	if textProp.Rotation != 0 {
+		left, top, _, _ := s.pdf.GetMargins()
+		fontHeight := textProp.Size / s.font.GetScaleFactor()
+
+		s.addRotated(textTranslated, textProp, actualCol*actualWidthPerCol+left, marginTop+top-fontHeight, actualWidthPerCol, 0)
+		return
+	}
 
-	stringWidth := s.pdf.GetStringWidth(textTranslated)
+	// A text with tab stops is a single line, its parts are positioned at the stops
+	if s.hasTabStops(textTranslated, textProp) {
+		s.addTabbedLine(textProp, actualCol, actualWidthPerCol, marginTop, textTranslated)
+		return
+	}
+
+	stringWidth := s.getStringWidth(textTranslated, textProp)
 	words := strings.Split(textTranslated, " ")
-	accumulateOffsetY := 0.0
 
 	// If should add one line
 	if stringWidth < actualWidthPerCol || textProp.Extrapolate || len(words) == 1 {
+		if textProp.Ellipsis && stringWidth > actualWidthPerCol {
+			textTranslated = s.cutWithEllipsis(textTranslated, textProp, actualWidthPerCol)
+			stringWidth = s.getStringWidth(textTranslated, textProp)
+		}
+
 		s.addLine(textProp, actualCol, actualWidthPerCol, marginTop, stringWidth, textTranslated)
 	} else {
-		lines := s.getLines(words, actualWidthPerCol)
+		lines := s.limitLines(s.getLines(words, textProp, actualWidthPerCol), textProp, actualWidthPerCol)
 
-		for index, line := range lines {
-			lineWidth := s.pdf.GetStringWidth(line)
-			_, _, fontSize := s.font.GetFont()
-			textHeight := fontSize / s.font.GetScaleFactor()
+		s.addLines(lines, textProp, marginTop, func(lineMarginTop, lineWidth float64, line string) {
+			s.addLine(textProp, actualCol, actualWidthPerCol, lineMarginTop, lineWidth, line)
+		})
+	}
+}
+
+// AddRotated add a text rotated by textProp.Rotation inside a cell which starts at marginTop and has colHeight,
+// the lines are wrapped so the bounding box of the rotated text fits the cell, and the box is placed with
+// Align and VerticalAlign. A colHeight of zero doesn't limit the height
+func (s *text) AddRotated(text string, textProp props.Text, marginTop float64, actualCol float64, qtdCols float64, colHeight float64) {
+	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
+	left, top, _, _ := s.pdf.GetMargins()
+
+	textTranslated, textProp := s.prepare(text, textProp)
+
+	s.addRotated(textTranslated, textProp, actualCol*actualWidthPerCol+left, marginTop+top, actualWidthPerCol, colHeight)
+}
+
+// GetRotatedHeight retrieve the height of the bounding box of a text rotated by textProp.Rotation,
+// with its lines wrapped to fit the cell width
+func (s *text) GetRotatedHeight(text string, textProp props.Text, qtdCols float64) float64 {
+	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
+
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+	textTranslated := s.translate(text, textProp)
+
+	lines := s.getRotatedLines(textTranslated, textProp, actualWidthPerCol, 0)
+	_, boxHeight := s.math.GetRotatedBoundingBox(s.getLinesWidth(lines, textProp), s.getLinesHeight(lines, textProp), textProp.Rotation)
+
+	return boxHeight
+}
 
-			s.addLine(textProp, actualCol, actualWidthPerCol, marginTop+float64(index)*textHeight+accumulateOffsetY, lineWidth, line)
-			accumulateOffsetY += textProp.VerticalPadding
+// prepare set the font and the color of a text and translate it, the direction
+// of the paragraph and its default align are resolved from the text when it is consts.Auto
+func (s *text) prepare(text string, textProp props.Text) (string, props.Text) {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+	s.pdf.SetTextColor(textProp.Color.Red, textProp.Color.Green, textProp.Color.Blue)
+
+	// Apply Unicode
+	textTranslated := s.translate(text, textProp)
+
+	if textProp.Direction != "" {
+		textProp.Direction = s.bidi.GetDirection(text, textProp.Direction)
+	}
+
+	// With consts.Auto the default align is known only with the direction of the text
+	if textProp.Align == "" {
+		textProp.Align = consts.Left
+		if textProp.Direction == consts.RightToLeft {
+			textProp.Align = consts.Right
 		}
 	}
+
+	return textTranslated, textProp
+}
+
+// addLines write the lines of a text with addLine, the first one at marginTop. Raised
+// and lowered parts move the lines away from its neighbours
+func (s *text) addLines(lines []string, textProp props.Text, marginTop float64, addLine func(marginTop, lineWidth float64, line string)) {
+	accumulateOffsetY := 0.0
+	previousBelow := 0.0
+
+	for index, line := range lines {
+		lineWidth := s.getStringWidth(line, textProp)
+		_, _, fontSize := s.font.GetFont()
+		textHeight := fontSize / s.font.GetScaleFactor()
+
+		above, below := s.getLineExtra(line, textHeight)
+		if index > 0 {
+			accumulateOffsetY += previousBelow + above
+		}
+
+		previousBelow = below
+		lineOffsetY := float64(index)*textHeight + accumulateOffsetY
+
+		// An explicit line height replaces the font height and the padding
+		if textProp.LineHeight > 0 {
+			lineOffsetY = float64(index) * textProp.LineHeight
+		}
+
+		addLine(marginTop+lineOffsetY, lineWidth, line)
+		accumulateOffsetY += textProp.VerticalPadding
+	}
+}
+
+// addRotated write a text rotated around the center of its bounding box, the box is
+// fitted inside the cell which starts at (cellX, cellY) and placed with the aligns
+func (s *text) addRotated(textTranslated string, textProp props.Text, cellX, cellY, width, height float64) {
+	lines := s.getRotatedLines(textTranslated, textProp, width, height)
+	linesWidth, linesHeight := s.getLinesWidth(lines, textProp), s.getLinesHeight(lines, textProp)
+	boxWidth, boxHeight := s.math.GetRotatedBoundingBox(linesWidth, linesHeight, textProp.Rotation)
+
+	boxX := cellX + s.math.GetAlignCorrection(textProp.Align, width, boxWidth)
+	boxY := cellY
+	if height > 0 {
+		boxY += s.math.GetAlignCorrection(textProp.VerticalAlign, height, boxHeight)
+	}
+
+	centerX, centerY := boxX+boxWidth/2.0, boxY+boxHeight/2.0
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+	x, y := centerX-linesWidth/2.0, centerY-linesHeight/2.0+fontHeight
+
+	rotate(s.pdf, textProp.Rotation, centerX, centerY, func() {
+		if s.hasTabStops(textTranslated, textProp) {
+			s.drawTabbedLine(textProp, x, linesWidth, y, textTranslated)
+			return
+		}
+
+		s.addLines(lines, textProp, y, func(lineY, lineWidth float64, line string) {
+			s.drawAlignedLine(textProp, x, linesWidth, lineY, lineWidth, line)
+		})
+	})
+}
+
+// getRotatedLines return the lines of a rotated text, wrapped to the longest width which keeps
+// the bounding box inside the cell. A height of zero doesn't limit the lines
+func (s *text) getRotatedLines(textTranslated string, textProp props.Text, width, height float64) []string {
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+
+	lines := s.getTextLines(textTranslated, textProp, s.getRotatedWidth(textProp.Rotation, width, height, fontHeight))
+
+	// The lines are wrapped again with the space taken by the height of all of them
+	if len(lines) > 1 {
+		linesHeight := s.getLinesHeight(lines, textProp)
+		lines = s.getTextLines(textTranslated, textProp, s.getRotatedWidth(textProp.Rotation, width, height, linesHeight))
+	}
+
+	// A single line longer than the cell is cut like the ones which aren't rotated
+	if len(lines) == 1 && textProp.Ellipsis && !s.hasTabStops(textTranslated, textProp) {
+		lineWidth := s.getRotatedWidth(textProp.Rotation, width, height, fontHeight)
+		if s.getStringWidth(lines[0], textProp) > lineWidth {
+			lines[0] = s.cutWithEllipsis(lines[0], textProp, lineWidth)
+		}
+	}
+
+	return lines
+}
+
+// getRotatedWidth return the longest line which, with the lines height and rotated by angle
+// degrees, fits the cell. The width isn't limited when no side of the cell limits it
+func (s *text) getRotatedWidth(angle, width, height, linesHeight float64) float64 {
+	radians := angle * gomath.Pi / 180.0
+	sin := gomath.Abs(gomath.Sin(radians))
+	cos := gomath.Abs(gomath.Cos(radians))
+
+	lineWidth := gomath.MaxFloat64
+
+	if cos > rotationEpsilon {
+		lineWidth = gomath.Min(lineWidth, (width-linesHeight*sin)/cos)
+	}
+
+	if sin > rotationEpsilon && height > 0 {
+		lineWidth = gomath.Min(lineWidth, (height-linesHeight*cos)/sin)
+	}
+
+	return lineWidth
+}
+
+// getLinesWidth return the width of the longest line
+func (s *text) getLinesWidth(lines []string, textProp props.Text) float64 {
+	linesWidth := 0.0
+
+	for _, line := range lines {
+		linesWidth = gomath.Max(linesWidth, s.getStringWidth(strings.TrimRight(line, " "), textProp))
+	}
+
+	return linesWidth
 }
 
 // GetLinesQuantity retrieve the quantity of lines which a text will occupy to avoid that text to extrapolate a cell
 func (s *text) GetLinesQuantity(text string, textProp props.Text, qtdCols float64) int {
 	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
 
-	translator := s.pdf.UnicodeTranslatorFromDescriptor("")
 	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
 
 	// Apply Unicode
-	textTranslated := translator(text)
+	textTranslated := s.translate(text, textProp)
 
-	stringWidth := s.pdf.GetStringWidth(textTranslated)
+	stringWidth := s.getStringWidth(textTranslated, textProp)
 	words := strings.Split(textTranslated, " ")
 
 	// If should add one line
-	if stringWidth < actualWidthPerCol || textProp.Extrapolate || len(words) == 1 {
+	if stringWidth < actualWidthPerCol || textProp.Extrapolate || len(words) == 1 || s.hasTabStops(textTranslated, textProp) {
 		return 1
 	}
 
-	lines := s.getLines(words, actualWidthPerCol)
+	lines := s.getLines(words, textProp, actualWidthPerCol)
+
+	if textProp.MaxLines > 0 && len(lines) > textProp.MaxLines {
+		return textProp.MaxLines
+	}
+
 	return len(lines)
 }
 
-func (s *text) getLines(words []string, actualWidthPerCol float64) []string {
+// GetStringWidth retrieve the width which a text will occupy written in a single line
+func (s *text) GetStringWidth(text string, textProp props.Text) float64 {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	return s.getStringWidth(s.translate(text, textProp), textProp)
+}
+
+// GetLinesHeight retrieve the distance between the top of a text and the baseline of its last line,
+// lines with superscript or subscript parts are moved away from its neighbours
+func (s *text) GetLinesHeight(text string, textProp props.Text, qtdCols float64) float64 {
+	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
+
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	return s.getLinesHeight(s.getTextLines(s.translate(text, textProp), textProp, actualWidthPerCol), textProp)
+}
+
+// GetFitSize retrieve the biggest font size, from textProp.Size down to textProp.MinSize,
+// which make a text fits the cell width and the height available
+func (s *text) GetFitSize(text string, textProp props.Text, qtdCols float64, height float64) float64 {
+	actualWidthPerCol := s.math.GetWidthPerCol(qtdCols)
+
+	textTranslated := s.translate(text, textProp)
+
+	for size := textProp.Size; size > textProp.MinSize; size -= fitSizeStep {
+		textProp.Size = size
+		if s.fits(textTranslated, textProp, actualWidthPerCol, height) {
+			return size
+		}
+	}
+
+	return textProp.MinSize
+}
+
+func (s *text) fits(textTranslated string, textProp props.Text, actualWidthPerCol float64, height float64) bool {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	textHeight := textProp.Size / s.font.GetScaleFactor()
+	words := strings.Split(textTranslated, " ")
+
+	if textProp.Extrapolate || len(words) == 1 || s.hasTabStops(textTranslated, textProp) {
+		return s.getStringWidth(textTranslated, textProp) <= actualWidthPerCol && textHeight <= height
+	}
+
+	for _, word := range words {
+		if s.getStringWidth(word, textProp) > actualWidthPerCol {
+			return false
+		}
+	}
+
+	lines := s.getLines(words, textProp, actualWidthPerCol)
+	if textProp.MaxLines > 0 && len(lines) > textProp.MaxLines {
+		return false
+	}
+
+	return s.getLinesHeight(lines, textProp) <= height
+}
+
+// getTextLines return the lines which a text will occupy, like Add
+func (s *text) getTextLines(textTranslated string, textProp props.Text, actualWidthPerCol float64) []string {
+	stringWidth := s.getStringWidth(textTranslated, textProp)
+	words := strings.Split(textTranslated, " ")
+
+	if stringWidth < actualWidthPerCol || textProp.Extrapolate || len(words) == 1 || s.hasTabStops(textTranslated, textProp) {
+		return []string{textTranslated}
+	}
+
+	return s.limitLines(s.getLines(words, textProp, actualWidthPerCol), textProp, actualWidthPerCol)
+}
+
+// getLinesHeight return the distance between the top of the first line and the baseline of the last one,
+// with the space of its lowered parts. An explicit line height is kept even when the lines have raised
+// or lowered parts
+func (s *text) getLinesHeight(lines []string, textProp props.Text) float64 {
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+
+	if textProp.LineHeight > 0 {
+		return fontHeight + float64(len(lines)-1)*textProp.LineHeight
+	}
+
+	height := 0.0
+	previousBelow := 0.0
+
+	for index, line := range lines {
+		above, below := s.getLineExtra(line, fontHeight)
+
+		height += fontHeight
+		if index > 0 {
+			height += textProp.VerticalPadding + previousBelow + above
+		}
+
+		previousBelow = below
+	}
+
+	return height + previousBelow
+}
+
+// getLineExtra return the space which the raised parts of a line need above it and
+// the lowered parts need below it. Only the lines after the first one are moved
+func (s *text) getLineExtra(line string, fontHeight float64) (above float64, below float64) {
+	if strings.Contains(line, superscriptMark) {
+		above = fontHeight * superscriptRise
+	}
+
+	if strings.Contains(line, subscriptMark) {
+		below = fontHeight * subscriptDrop
+	}
+
+	return above, below
+}
+
+// getRunsExtra return the most space which the superscript and subscript parts of a text need
+// around its lines, when each part is in a different line. Only the lines after the first one
+// are moved by a superscript, a subscript also moves the space below the last line
+func getRunsExtra(text string, qtdLines float64, fontHeight float64) float64 {
+	raised := gomath.Min(float64(strings.Count(text, superscriptMark)/2), qtdLines-1)
+	lowered := gomath.Min(float64(strings.Count(text, subscriptMark)/2), qtdLines)
+
+	return gomath.Max(raised, 0.0)*fontHeight*superscriptRise + gomath.Max(lowered, 0.0)*fontHeight*subscriptDrop
+}
+
+// limitLines discard the lines after textProp.MaxLines, marking the last kept line
+// with an ellipsis when required
+func (s *text) limitLines(lines []string, textProp props.Text, actualWidthPerCol float64) []string {
+	if textProp.MaxLines <= 0 || len(lines) <= textProp.MaxLines {
+		return lines
+	}
+
+	lines = lines[:textProp.MaxLines]
+
+	if textProp.Ellipsis {
+		last := len(lines) - 1
+		lines[last] = s.cutWithEllipsis(lines[last], textProp, actualWidthPerCol)
+	}
+
+	return lines
+}
+
+// cutWithEllipsis remove characters from the end of a line until
+// the line followed by an ellipsis fits the width
+func (s *text) cutWithEllipsis(line string, textProp props.Text, width float64) string {
+	line = strings.TrimRight(line, " ")
+
+	for len(line) > 0 && s.getStringWidth(line+ellipsis, textProp) > width {
+		_, size := utf8.DecodeLastRuneInString(line)
+		line = line[:len(line)-size]
+	}
+
+	return strings.TrimRight(line, " ") + ellipsis
+}
+
+func (s *text) getLines(words []string, textProp props.Text, actualWidthPerCol float64) []string {
 	currentlySize := 0.0
 	actualLine := 0
 
@@ -90,25 +497,213 @@ func (s *text) getLines(words []string, actualWidthPerCol float64) []string {
 	lines = append(lines, "")
 
 	for _, word := range words {
-		if s.pdf.GetStringWidth(word+" ")+currentlySize < actualWidthPerCol {
+		if s.getStringWidth(word+" ", textProp)+currentlySize < actualWidthPerCol {
 			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize += s.pdf.GetStringWidth(word + " ")
+			currentlySize += s.getStringWidth(word+" ", textProp)
 		} else {
 			lines = append(lines, "")
 			actualLine++
 			lines[actualLine] = lines[actualLine] + word + " "
-			currentlySize = s.pdf.GetStringWidth(word + " ")
+			currentlySize = s.getStringWidth(word+" ", textProp)
+		}
+	}
+
+	return s.balanceRuns(lines)
+}
+
+// balanceRuns close the runs which continue in the next line and start them again there,
+// so each line can be written alone
+func (s *text) balanceRuns(lines []string) []string {
+	for index := 0; index < len(lines)-1; index++ {
+		for _, mark := range []string{superscriptMark, subscriptMark, smallCapsMark} {
+			if strings.Count(lines[index], mark)%2 == 1 {
+				lines[index] += mark
+				lines[index+1] = mark + lines[index+1]
+			}
 		}
 	}
 
 	return lines
 }
 
+// getStringWidth measure a string, adding the letter spacing after each character
+func (s *text) getStringWidth(value string, textProp props.Text) float64 {
+	if strings.ContainsAny(value, runMarks) {
+		return s.getRunsWidth(value, textProp)
+	}
+
+	width := s.pdf.GetStringWidth(value)
+
+	if textProp.LetterSpacing != 0 {
+		width += textProp.LetterSpacing * float64(utf8.RuneCountInString(value))
+	}
+
+	return width
+}
+
+// getRunsWidth measure a string with superscript, subscript or small caps parts, which are measured with their size
+func (s *text) getRunsWidth(value string, textProp props.Text) float64 {
+	fontSize, _ := s.pdf.GetFontSize()
+	width := 0.0
+
+	for _, run := range s.splitRuns(value, textProp) {
+		if run.mark == "" {
+			width += s.getStringWidth(run.text, textProp)
+			continue
+		}
+
+		s.pdf.SetFontSize(fontSize * s.getRunScale(run))
+		width += s.getStringWidth(run.text, textProp)
+		s.pdf.SetFontSize(fontSize)
+	}
+
+	return width
+}
+
+// splitRuns split a line in runs, each mark starts and ends a run of its kind. The lower case
+// letters of a small caps run are a run of its own, written in upper case, the other characters
+// of a small caps run are written as normal text
+func (s *text) splitRuns(value string, textProp props.Text) []run {
+	runs := []run{}
+	mark := ""
+	start := 0
+
+	for index := 0; index < len(value); index++ {
+		current := value[index : index+1]
+		if !strings.Contains(runMarks, current) || (mark != "" && current != mark) {
+			continue
+		}
+
+		runs = append(runs, s.newRuns(value[start:index], mark, textProp)...)
+		start = index + 1
+
+		if mark == "" {
+			mark = current
+		} else {
+			mark = ""
+		}
+	}
+
+	return append(runs, s.newRuns(value[start:], mark, textProp)...)
+}
+
+// newRuns return the runs of a part of a line, a small caps part is split by the case of its letters
+func (s *text) newRuns(value string, mark string, textProp props.Text) []run {
+	if value == "" {
+		return nil
+	}
+
+	if mark != smallCapsMark {
+		return []run{{text: value, mark: mark}}
+	}
+
+	// Texts with a font which isn't UTF-8 are already translated to cp1252
+	utf8Text := s.font.IsUTF8(textProp.Family)
+
+	runs := []run{}
+	for len(value) > 0 {
+		lower := s.isLower(value, utf8Text)
+
+		end := s.getCharSize(value, utf8Text)
+		for end < len(value) && s.isLower(value[end:], utf8Text) == lower {
+			end += s.getCharSize(value[end:], utf8Text)
+		}
+
+		if lower {
+			runs = append(runs, run{text: s.toUpper(value[:end], utf8Text), mark: smallCapsMark})
+		} else {
+			runs = append(runs, run{text: value[:end]})
+		}
+
+		value = value[end:]
+	}
+
+	return runs
+}
+
+// getCharSize return the size in bytes of the first character of a text
+func (s *text) getCharSize(value string, utf8Text bool) int {
+	if !utf8Text {
+		return 1
+	}
+
+	_, size := utf8.DecodeRuneInString(value)
+
+	return size
+}
+
+// isLower return if the first character of a text is a lower case letter
+func (s *text) isLower(value string, utf8Text bool) bool {
+	if utf8Text {
+		char, _ := utf8.DecodeRuneInString(value)
+		return unicode.IsLower(char)
+	}
+
+	// cp1252 has the lower case accented letters from 0xE0, except the division sign
+	char := value[0]
+
+	return (char >= 'a' && char <= 'z') || (char >= 0xE0 && char <= 0xFE && char != 0xF7)
+}
+
+// toUpper convert the lower case letters of a text
+func (s *text) toUpper(value string, utf8Text bool) string {
+	if utf8Text {
+		return strings.ToUpper(value)
+	}
+
+	upper := []byte(value)
+	for index := range upper {
+		if s.isLower(string(upper[index]), false) {
+			upper[index] -= 0x20
+		}
+	}
+
+	return string(upper)
+}
+
+// getRunScale return the size of a run, relative to the font size
+func (s *text) getRunScale(value run) float64 {
+	switch value.mark {
+	case superscriptMark:
+		return superscriptScale
+	case subscriptMark:
+		return subscriptScale
+	case smallCapsMark:
+		return smallCapsScale
+	}
+
+	return 1.0
+}
+
+// translate prepare a text to be measured and written, texts with an UTF-8 font are kept
+// in UTF-8 and shaped when they have a direction, the others are translated to cp1252
+func (s *text) translate(text string, textProp props.Text) string {
+	if !s.font.IsUTF8(textProp.Family) {
+		return s.pdf.UnicodeTranslatorFromDescriptor("")(text)
+	}
+
+	if textProp.Direction != "" {
+		return s.bidi.Shape(text)
+	}
+
+	return text
+}
+
 func (s *text) addLine(textProp props.Text, actualCol, actualWidthPerCol, marginTop, stringWidth float64, textTranslated string) {
 	left, top, _, _ := s.pdf.GetMargins()
 
+	s.drawAlignedLine(textProp, actualCol*actualWidthPerCol+left, actualWidthPerCol, marginTop+top, stringWidth, textTranslated)
+}
+
+// drawAlignedLine write a line aligned inside a space which starts at x and has the width, with the baseline at y
+func (s *text) drawAlignedLine(textProp props.Text, x, width, y, stringWidth float64, textTranslated string) {
+	// Lines are wrapped in the logical order, and written in the visual order
+	if textProp.Direction != "" {
+		textTranslated = s.reorder(strings.TrimRight(textTranslated, " "), textProp)
+	}
+
 	if textProp.Align == consts.Left {
-		s.pdf.Text(actualCol*actualWidthPerCol+left, marginTop+top, textTranslated)
+		s.drawLine(textProp, x, y, textTranslated)
 		return
 	}
 
@@ -118,7 +713,229 @@ func (s *text) addLine(textProp props.Text, actualCol, actualWidthPerCol, margin
 		modifier = 1
 	}
 
-	dx := (actualWidthPerCol - stringWidth) / modifier
+	dx := (width - stringWidth) / modifier
+
+	s.drawLine(textProp, dx+x, y, textTranslated)
+}
+
+// hasTabStops return if a text is written with tab stops
+func (s *text) hasTabStops(textTranslated string, textProp props.Text) bool {
+	return len(textProp.TabStops) > 0 && strings.Contains(textTranslated, tab)
+}
+
+// addTabbedLine write a line whose parts after each tab character are aligned to the next tab
+// stop, the space between the previous part and a part is filled with the leader of its stop
+func (s *text) addTabbedLine(textProp props.Text, actualCol, actualWidthPerCol, marginTop float64, textTranslated string) {
+	left, top, _, _ := s.pdf.GetMargins()
+
+	s.drawTabbedLine(textProp, actualCol*actualWidthPerCol+left, actualWidthPerCol, marginTop+top, textTranslated)
+}
+
+// drawTabbedLine write a line with tab stops inside a cell which starts at cellX, with the baseline at y
+func (s *text) drawTabbedLine(textProp props.Text, cellX, actualWidthPerCol, y float64, textTranslated string) {
+	tabStops := s.getTabStops(textProp, actualWidthPerCol)
+	parts := strings.Split(textTranslated, tab)
+
+	s.drawTabbedPart(textProp, cellX, actualWidthPerCol, 0.0, y, parts[0])
+	x := s.getStringWidth(parts[0], textProp)
+
+	for _, part := range parts[1:] {
+		start := x + s.getStringWidth(" ", textProp)
+
+		for _, tabStop := range tabStops {
+			if tabStop.Position > x {
+				start = tabStop.Position - s.getTabStopOffset(part, tabStop, textProp)
+				if start < x {
+					start = x
+				}
+
+				if textProp.Direction == consts.RightToLeft {
+					s.drawLeader(textProp, cellX+actualWidthPerCol-start, cellX+actualWidthPerCol-x, y, tabStop.Leader)
+				} else {
+					s.drawLeader(textProp, cellX+x, cellX+start, y, tabStop.Leader)
+				}
+
+				break
+			}
+		}
+
+		s.drawTabbedPart(textProp, cellX, actualWidthPerCol, start, y, part)
+		x = start + s.getStringWidth(part, textProp)
+	}
+}
+
+// reorder return a line in the visual order. Texts with a font which isn't UTF-8 are
+// translated to cp1252, which has no right-to-left letters, so they keep the logical order
+func (s *text) reorder(line string, textProp props.Text) string {
+	if !s.font.IsUTF8(textProp.Family) {
+		return line
+	}
+
+	return s.bidi.Reorder(line, textProp.Direction)
+}
+
+// drawTabbedPart write a part of a line with tab stops which starts at start from the cell boundary, the
+// left one or, in a right-to-left paragraph, the right one. Parts are written in the visual order
+func (s *text) drawTabbedPart(textProp props.Text, cellX, actualWidthPerCol, start, y float64, part string) {
+	if textProp.Direction == "" {
+		s.drawLine(textProp, cellX+start, y, part)
+		return
+	}
+
+	part = s.reorder(part, textProp)
+
+	if textProp.Direction == consts.RightToLeft {
+		s.drawLine(textProp, cellX+actualWidthPerCol-start-s.getStringWidth(part, textProp), y, part)
+		return
+	}
+
+	s.drawLine(textProp, cellX+start, y, part)
+}
+
+// getTabStops return the tab stops sorted by position, limited to the cell width
+// and with the leaders and separators translated like the text
+func (s *text) getTabStops(textProp props.Text, actualWidthPerCol float64) []props.TabStop {
+	tabStops := make([]props.TabStop, len(textProp.TabStops))
+
+	for index, tabStop := range textProp.TabStops {
+		if tabStop.Position > actualWidthPerCol {
+			tabStop.Position = actualWidthPerCol
+		}
+
+		tabStop.Leader = s.translate(tabStop.Leader, textProp)
+		tabStop.DecimalSeparator = s.translate(tabStop.DecimalSeparator, textProp)
+		tabStops[index] = tabStop
+	}
+
+	sort.SliceStable(tabStops, func(i, j int) bool {
+		return tabStops[i].Position < tabStops[j].Position
+	})
+
+	return tabStops
+}
+
+// getTabStopOffset return the distance between the start of a part and the tab stop, a number
+// without the decimal separator is aligned by its end with consts.DecimalPoint
+func (s *text) getTabStopOffset(part string, tabStop props.TabStop, textProp props.Text) float64 {
+	switch tabStop.Align {
+	case consts.Right:
+		return s.getStringWidth(part, textProp)
+	case consts.Center:
+		return s.getStringWidth(part, textProp) / 2.0
+	case consts.DecimalPoint:
+		if index := strings.Index(part, tabStop.DecimalSeparator); index >= 0 {
+			return s.getStringWidth(part[:index], textProp)
+		}
+
+		return s.getStringWidth(part, textProp)
+	}
+
+	return 0.0
+}
+
+// drawLeader repeat the leader as many times as it fits between from and to, ending exactly at to
+func (s *text) drawLeader(textProp props.Text, from, to, y float64, leader string) {
+	if leader == "" {
+		return
+	}
+
+	leaderWidth := s.getStringWidth(leader, textProp)
+	if leaderWidth <= 0 {
+		return
+	}
+
+	count := int((to - from) / leaderWidth)
+	if count < 1 {
+		return
+	}
+
+	s.drawRun(textProp, to-float64(count)*leaderWidth, y, strings.Repeat(leader, count))
+}
+
+// drawLine write a line which starts at x with the baseline at y,
+// applying letter spacing and decorations
+func (s *text) drawLine(textProp props.Text, x, y float64, textTranslated string) {
+	if strings.ContainsAny(textTranslated, runMarks) {
+		s.drawRuns(textProp, x, y, textTranslated)
+	} else {
+		s.drawRun(textProp, x, y, textTranslated)
+	}
+
+	if textProp.Underline || textProp.StrikeThrough || textProp.Overline {
+		s.addDecorations(textProp, x, y, s.getStringWidth(strings.TrimRight(textTranslated, " "), textProp))
+	}
+}
+
+// drawRuns write a line with superscript, subscript or small caps parts, each part starts where the previous ends
+func (s *text) drawRuns(textProp props.Text, x, y float64, textTranslated string) {
+	fontSize, _ := s.pdf.GetFontSize()
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+
+	for _, run := range s.splitRuns(textTranslated, textProp) {
+		if run.mark == "" {
+			s.drawRun(textProp, x, y, run.text)
+			x += s.getStringWidth(run.text, textProp)
+			continue
+		}
+
+		runY := y
+		if run.mark == superscriptMark {
+			runY -= fontHeight * superscriptRise
+		} else if run.mark == subscriptMark {
+			runY += fontHeight * subscriptDrop
+		}
+
+		s.pdf.SetFontSize(fontSize * s.getRunScale(run))
+		s.drawRun(textProp, x, runY, run.text)
+		x += s.getStringWidth(run.text, textProp)
+		s.pdf.SetFontSize(fontSize)
+	}
+}
+
+// drawRun write a text which starts at x with the baseline at y, applying letter spacing
+func (s *text) drawRun(textProp props.Text, x, y float64, run string) {
+	if textProp.LetterSpacing == 0 {
+		s.pdf.Text(x, y, run)
+		return
+	}
+
+	charX := x
+	for index := 0; index < len(run); {
+		_, size := utf8.DecodeRuneInString(run[index:])
+		char := run[index : index+size]
+
+		s.pdf.Text(charX, y, char)
+		charX += s.pdf.GetStringWidth(char) + textProp.LetterSpacing
+		index += size
+	}
+}
+
+// addDecorations draw underline, strike-through and overline with the text color,
+// positions and thickness are proportional to the font height
+func (s *text) addDecorations(textProp props.Text, x, y, width float64) {
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.font.GetScaleFactor()
+
+	lineWidth := s.pdf.GetLineWidth()
+	red, green, blue := s.pdf.GetDrawColor()
+
+	s.pdf.SetLineWidth(fontHeight * 0.05)
+	s.pdf.SetDrawColor(textProp.Color.Red, textProp.Color.Green, textProp.Color.Blue)
+
+	if textProp.Underline {
+		s.pdf.Line(x, y+fontHeight*0.1, x+width, y+fontHeight*0.1)
+	}
+
+	if textProp.StrikeThrough {
+		s.pdf.Line(x, y-fontHeight*0.25, x+width, y-fontHeight*0.25)
+	}
+
+	if textProp.Overline {
+		s.pdf.Line(x, y-fontHeight*0.75, x+width, y-fontHeight*0.75)
+	}
 
-	s.pdf.Text(dx+actualCol*actualWidthPerCol+left, marginTop+top, textTranslated)
+	s.pdf.SetLineWidth(lineWidth)
+	s.pdf.SetDrawColor(red, green, blue)
 }
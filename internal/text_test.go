@@ -30,6 +30,7 @@ func TestText_GetLinesQuantity_WhenStringSmallerThanLimits(t *testing.T) {
 	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
 
 	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
 	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	sut := internal.NewText(pdf, math, font)
@@ -53,6 +54,7 @@ func TestText_GetLinesQuantity_WhenHasOneWord(t *testing.T) {
 	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
 
 	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
 	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	sut := internal.NewText(pdf, math, font)
@@ -76,6 +78,7 @@ func TestText_GetLinesQuantity_WhenExtrapolate(t *testing.T) {
 	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
 
 	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
 	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	sut := internal.NewText(pdf, math, font)
@@ -99,6 +102,7 @@ func TestText_GetLinesQuantity_WhenHasToBreakLines(t *testing.T) {
 	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
 
 	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
 	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	sut := internal.NewText(pdf, math, font)
@@ -110,6 +114,670 @@ func TestText_GetLinesQuantity_WhenHasToBreakLines(t *testing.T) {
 	assert.Equal(t, lines, 3)
 }
 
+func TestText_GetLinesQuantity_WhenHasMaxLines(t *testing.T) {
+	// Arrange
+	pdf := &mocks.Pdf{}
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(text string) string {
+		return text
+	})
+	pdf.On("GetStringWidth", mock.Anything).Return(15.0)
+
+	math := &mocks.Math{}
+	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
+
+	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
+	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	sut := internal.NewText(pdf, math, font)
+
+	// Act
+	lines := sut.GetLinesQuantity("Many words", props.Text{MaxLines: 2}, 2)
+
+	// Assert
+	assert.Equal(t, lines, 2)
+}
+
+func TestText_GetStringWidth(t *testing.T) {
+	// Arrange
+	pdf := &mocks.Pdf{}
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) })
+
+	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
+	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	sut := internal.NewText(pdf, &mocks.Math{}, font)
+
+	// Act
+	width := sut.GetStringWidth("Text", props.Text{Family: consts.Courier, Size: 12.0, LetterSpacing: 0.5})
+
+	// Assert
+	assert.Equal(t, width, 6.0)
+	font.AssertCalled(t, "SetFont", consts.Courier, consts.Style(""), 12.0)
+}
+
+func TestText_GetFitSize(t *testing.T) {
+	cases := []struct {
+		name   string
+		text   string
+		prop   props.Text
+		height float64
+		width  func(size float64) func(string) float64
+		assert func(t *testing.T, size float64)
+	}{
+		{
+			"When text already fits, should keep size",
+			"Many words",
+			props.Text{Size: 10.0, MinSize: 4.0},
+			20.0,
+			func(size float64) func(string) float64 {
+				return func(string) float64 { return 2.0 }
+			},
+			func(t *testing.T, size float64) {
+				assert.Equal(t, size, 10.0)
+			},
+		},
+		{
+			"When extrapolate text is wider than cell, should reduce size",
+			"Many words",
+			props.Text{Size: 10.0, MinSize: 4.0, Extrapolate: true},
+			20.0,
+			func(size float64) func(string) float64 {
+				return func(string) float64 { return size * 1.25 }
+			},
+			func(t *testing.T, size float64) {
+				assert.Equal(t, size, 8.0)
+			},
+		},
+		{
+			"When text never fits, should return min size",
+			"Many words",
+			props.Text{Size: 10.0, MinSize: 4.0},
+			20.0,
+			func(size float64) func(string) float64 {
+				return func(string) float64 { return 50.0 }
+			},
+			func(t *testing.T, size float64) {
+				assert.Equal(t, size, 4.0)
+			},
+		},
+		{
+			"When wrapped lines are higher than the row, should reduce size",
+			"Many words",
+			props.Text{Size: 10.0, MinSize: 4.0},
+			12.0,
+			func(size float64) func(string) float64 {
+				return func(string) float64 { return 6.0 }
+			},
+			func(t *testing.T, size float64) {
+				assert.Equal(t, size, 6.0)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		size := c.prop.Size
+
+		pdf := &mocks.Pdf{}
+		pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(text string) string {
+			return text
+		})
+		pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 {
+			return c.width(size)(value)
+		})
+
+		math := &mocks.Math{}
+		math.On("GetWidthPerCol", mock.Anything).Return(10.0)
+
+		font := &mocks.Font{}
+		font.On("IsUTF8", mock.Anything).Return(false)
+		font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			size = args.Get(2).(float64)
+		})
+		font.On("GetScaleFactor").Return(1.0)
+
+		sut := internal.NewText(pdf, math, font)
+
+		// Act
+		fitSize := sut.GetFitSize(c.text, c.prop, 2, c.height)
+
+		// Assert
+		c.assert(t, fitSize)
+	}
+}
+
+func TestText_Add_WhenEllipsis(t *testing.T) {
+	cases := []struct {
+		name      string
+		text      string
+		prop      props.Text
+		assertPdf func(t *testing.T, pdf *mocks.Pdf)
+	}{
+		{
+			"When extrapolate text is wider than cell, should cut with ellipsis",
+			"ABCDEFGHIJ",
+			props.Text{Align: consts.Left, Extrapolate: true, Ellipsis: true},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "Text", 1)
+				pdf.AssertCalled(t, "Text", 10.0, 15.0, "AB...")
+			},
+		},
+		{
+			"When lines are more than max lines, should cut last line with ellipsis",
+			"AB CD EF GH",
+			props.Text{Align: consts.Left, MaxLines: 2, Ellipsis: true},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "Text", 2)
+				pdf.AssertCalled(t, "Text", 10.0, 15.0, "AB ")
+				pdf.AssertCalled(t, "Text", 10.0, 16.0, "CD...")
+			},
+		},
+		{
+			"When lines are more than max lines without ellipsis, should only discard lines",
+			"AB CD EF GH",
+			props.Text{Align: consts.Left, MaxLines: 3},
+			func(t *testing.T, pdf *mocks.Pdf) {
+				pdf.AssertNumberOfCalls(t, "Text", 3)
+				pdf.AssertCalled(t, "Text", 10.0, 17.0, "EF ")
+				pdf.AssertNotCalled(t, "Text", 10.0, 18.0, "GH ")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		_pdf := &mocks.Pdf{}
+		_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+		_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) })
+		_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+		_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+		_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+		_math := &mocks.Math{}
+		_math.On("GetWidthPerCol", mock.Anything).Return(5.0)
+
+		_font := &mocks.Font{}
+		_font.On("IsUTF8", mock.Anything).Return(false)
+		_font.On("GetScaleFactor").Return(1.0)
+		_font.On("GetFont").Return(consts.Arial, consts.Normal, 1.0)
+		_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+		text := internal.NewText(_pdf, _math, _font)
+
+		// Act
+		text.Add(c.text, c.prop, 5.0, 0, 1)
+
+		// Assert
+		c.assertPdf(t, _pdf)
+	}
+}
+
+func TestText_Add_WhenRotated(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(12.0)
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+	_pdf.On("TransformBegin")
+	_pdf.On("TransformRotate", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("TransformEnd")
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(123.0)
+	_math.On("GetRotatedBoundingBox", 12.0, 8.0, 90.0).Return(8.0, 12.0)
+	_math.On("GetAlignCorrection", consts.Left, 123.0, 8.0).Return(0.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(2.0)
+	_font.On("GetFont").Return(consts.Arial, consts.Normal, 16.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("Vertical", props.Text{Size: 16.0, Align: consts.Left, Rotation: 90}, 20.0, 1, 15.0)
+
+	// Assert
+	// The bounding box starts at the top of the first line and is rotated around its center
+	_pdf.AssertNumberOfCalls(t, "TransformBegin", 1)
+	_pdf.AssertCalled(t, "TransformRotate", 90.0, 137.0, 28.0)
+	_pdf.AssertCalled(t, "Text", 131.0, 32.0, "Vertical")
+	_pdf.AssertNumberOfCalls(t, "TransformEnd", 1)
+}
+
+func TestText_AddRotated(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(12.0)
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+	_pdf.On("TransformBegin")
+	_pdf.On("TransformRotate", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("TransformEnd")
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(123.0)
+	_math.On("GetRotatedBoundingBox", 12.0, 8.0, 90.0).Return(8.0, 12.0)
+	_math.On("GetAlignCorrection", consts.Center, 123.0, 8.0).Return(57.5)
+	_math.On("GetAlignCorrection", consts.Bottom, 30.0, 12.0).Return(18.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(2.0)
+	_font.On("GetFont").Return(consts.Arial, consts.Normal, 16.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.AddRotated("Header", props.Text{Size: 16.0, Align: consts.Center, VerticalAlign: consts.Bottom, Rotation: 90}, 20.0, 1, 15.0, 30.0)
+
+	// Assert
+	// The bounding box is placed at the center and at the bottom of the cell
+	_pdf.AssertCalled(t, "TransformRotate", 90.0, 194.5, 54.0)
+	_pdf.AssertCalled(t, "Text", 188.5, 58.0, "Header")
+}
+
+func TestText_AddRotated_WhenLongerThanTheCell(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) * 2.0 })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+	_pdf.On("TransformBegin")
+	_pdf.On("TransformRotate", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("TransformEnd")
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(123.0)
+	_math.On("GetRotatedBoundingBox", 14.0, 16.0, 90.0).Return(16.0, 14.0)
+	_math.On("GetAlignCorrection", mock.Anything, mock.Anything, mock.Anything).Return(0.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(2.0)
+	_font.On("GetFont").Return(consts.Arial, consts.Normal, 16.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.AddRotated("One two three", props.Text{Size: 16.0, Align: consts.Left, Rotation: 90}, 20.0, 1, 15.0, 20.0)
+
+	// Assert
+	// The lines are wrapped to the height of the cell, which is the width of the rotated text
+	_pdf.AssertNumberOfCalls(t, "Text", 2)
+	_pdf.AssertCalled(t, "TransformRotate", 90.0, 141.0, 37.0)
+	_pdf.AssertCalled(t, "Text", 134.0, 37.0, "One two ")
+	_pdf.AssertCalled(t, "Text", 134.0, 45.0, "three ")
+}
+
+func TestText_Add_WhenDecorated(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(12.0)
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+	_pdf.On("GetLineWidth").Return(0.2)
+	_pdf.On("GetDrawColor").Return(1, 2, 3)
+	_pdf.On("SetLineWidth", mock.Anything)
+	_pdf.On("SetDrawColor", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("Line", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(123.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(2.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("Decorated", props.Text{Size: 20.0, Align: consts.Left, Underline: true, StrikeThrough: true, Overline: true}, 20.0, 1, 15.0)
+
+	// Assert
+	_pdf.AssertCalled(t, "Text", 133.0, 30.0, "Decorated")
+	_pdf.AssertNumberOfCalls(t, "Line", 3)
+	_pdf.AssertCalled(t, "Line", 133.0, 31.0, 145.0, 31.0)
+	_pdf.AssertCalled(t, "Line", 133.0, 27.5, 145.0, 27.5)
+	_pdf.AssertCalled(t, "Line", 133.0, 22.5, 145.0, 22.5)
+	_pdf.AssertCalled(t, "SetLineWidth", 0.5)
+	_pdf.AssertCalled(t, "SetLineWidth", 0.2)
+	_pdf.AssertCalled(t, "SetDrawColor", 1, 2, 3)
+}
+
+func TestText_Add_WhenLetterSpacing(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) * 2.0 })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(14.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("GetFont").Return(consts.Arial, consts.Normal, 5.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("AB CD", props.Text{Size: 5.0, Align: consts.Left, LetterSpacing: 1.0, LineHeight: 8.0}, 0.0, 0, 1)
+
+	// Assert
+	_pdf.AssertNumberOfCalls(t, "Text", 6)
+	_pdf.AssertCalled(t, "Text", 10.0, 10.0, "A")
+	_pdf.AssertCalled(t, "Text", 13.0, 10.0, "B")
+	_pdf.AssertCalled(t, "Text", 16.0, 10.0, " ")
+	_pdf.AssertCalled(t, "Text", 10.0, 18.0, "C")
+	_pdf.AssertCalled(t, "Text", 13.0, 18.0, "D")
+}
+
+func TestText_Add_WhenSuperscript(t *testing.T) {
+	// Arrange
+	fontSize := 10.0
+
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) * fontSize / 10.0 })
+	_pdf.On("GetFontSize").Return(func() float64 { return fontSize }, 0.0)
+	_pdf.On("SetFontSize", mock.Anything).Run(func(args mock.Arguments) {
+		fontSize = args.Get(0).(float64)
+	})
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(20.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("Revenue"+internal.Superscript("12")+" grew", props.Text{Size: 10.0, Align: consts.Left}, 0.0, 0, 1)
+
+	// Assert
+	_pdf.AssertNumberOfCalls(t, "Text", 3)
+	_pdf.AssertCalled(t, "Text", 10.0, 10.0, "Revenue")
+	_pdf.AssertCalled(t, "Text", 17.0, 6.5, "12")
+	_pdf.AssertCalled(t, "Text", 18.2, 10.0, " grew")
+	_pdf.AssertCalled(t, "SetFontSize", 6.0)
+	assert.Equal(t, 10.0, fontSize)
+}
+
+func TestText_Add_WhenTabStops(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) * 2.0 })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(40.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+	textProp := props.Text{
+		Size:  5.0,
+		Align: consts.Center,
+		TabStops: []props.TabStop{
+			{Position: 36.0, Align: consts.DecimalPoint, DecimalSeparator: "."},
+			{Position: 30.0, Align: consts.Right, Leader: "."},
+		},
+	}
+
+	// Act
+	text.Add("Tea\t2.50\t12.5\tL", textProp, 0.0, 0, 1)
+
+	// Assert
+	_pdf.AssertNumberOfCalls(t, "Text", 5)
+	_pdf.AssertCalled(t, "Text", 10.0, 10.0, "Tea")
+	_pdf.AssertCalled(t, "Text", 16.0, 10.0, "........")
+	_pdf.AssertCalled(t, "Text", 32.0, 10.0, "2.50")
+	_pdf.AssertCalled(t, "Text", 42.0, 10.0, "12.5")
+	_pdf.AssertCalled(t, "Text", 52.0, 10.0, "L")
+}
+
+func TestText_GetLinesQuantity_WhenTabStops(t *testing.T) {
+	// Arrange
+	pdf := &mocks.Pdf{}
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(text string) string {
+		return text
+	})
+	pdf.On("GetStringWidth", mock.Anything).Return(15.0)
+
+	math := &mocks.Math{}
+	math.On("GetWidthPerCol", mock.Anything).Return(10.0)
+
+	font := &mocks.Font{}
+	font.On("IsUTF8", mock.Anything).Return(false)
+	font.On("SetFont", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	sut := internal.NewText(pdf, math, font)
+
+	// Act
+	lines := sut.GetLinesQuantity("Long espresso\t2.50", props.Text{TabStops: []props.TabStop{{Position: 10.0}}}, 2)
+
+	// Assert
+	assert.Equal(t, lines, 1)
+}
+
+func TestRemoveRunMarks(t *testing.T) {
+	// Act
+	text := internal.RemoveRunMarks("H" + internal.Subscript("2") + "O " + internal.Superscript("1") + internal.SmallCaps("Acme"))
+
+	// Assert
+	assert.Equal(t, text, "H2O 1Acme")
+}
+
+func TestText_Add_WhenSubscriptAndSmallCaps(t *testing.T) {
+	// Arrange
+	fontSize := 10.0
+
+	_pdf := &mocks.Pdf{}
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) * fontSize / 2.0 })
+	_pdf.On("GetFontSize").Return(func() float64 { return fontSize }, 0.0)
+	_pdf.On("SetFontSize", mock.Anything).Run(func(args mock.Arguments) {
+		fontSize = args.Get(0).(float64)
+	})
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(200.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("H"+internal.Subscript("2")+"O "+internal.SmallCaps("Acme co"), props.Text{Size: 10.0, Align: consts.Left}, 0.0, 0, 1)
+
+	// Assert
+	_pdf.AssertNumberOfCalls(t, "Text", 7)
+	_pdf.AssertCalled(t, "Text", 10.0, 10.0, "H")
+	_pdf.AssertCalled(t, "Text", 15.0, 12.0, "2")
+	_pdf.AssertCalled(t, "Text", 18.0, 10.0, "O ")
+	_pdf.AssertCalled(t, "Text", 28.0, 10.0, "A")
+	_pdf.AssertCalled(t, "Text", 33.0, 10.0, "CME")
+	_pdf.AssertCalled(t, "Text", 44.25, 10.0, " ")
+	_pdf.AssertCalled(t, "Text", 49.25, 10.0, "CO")
+	_pdf.AssertCalled(t, "SetFontSize", 6.0)
+	_pdf.AssertCalled(t, "SetFontSize", 7.5)
+	assert.Equal(t, 10.0, fontSize)
+}
+
+func TestText_GetLinesHeight(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		textProp props.Text
+		height   float64
+	}{
+		{"When there are no raised or lowered parts", "aaaa bb cccc", props.Text{Size: 10.0}, 30.0},
+		{"When a line has a subscript", "aaaa " + internal.Subscript("bb") + " cccc", props.Text{Size: 10.0, VerticalPadding: 1.0}, 34.0},
+		{"When a line has a superscript", "aaaa " + internal.Superscript("bb") + " cccc", props.Text{Size: 10.0}, 33.5},
+		{"When line height is defined", "aaaa " + internal.Subscript("bb") + " cccc", props.Text{Size: 10.0, LineHeight: 8.0}, 26.0},
+		{"When a text has one line", internal.Subscript("bb"), props.Text{Size: 10.0}, 12.0},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		_pdf := &mocks.Pdf{}
+		_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+		_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) })
+		_pdf.On("GetFontSize").Return(10.0, 0.0)
+		_pdf.On("SetFontSize", mock.Anything)
+
+		_math := &mocks.Math{}
+		_math.On("GetWidthPerCol", mock.Anything).Return(6.0)
+
+		_font := &mocks.Font{}
+		_font.On("IsUTF8", mock.Anything).Return(false)
+		_font.On("GetScaleFactor").Return(1.0)
+		_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+		text := internal.NewText(_pdf, _math, _font)
+
+		// Act
+		height := text.GetLinesHeight(c.text, c.textProp, 1)
+
+		// Assert
+		assert.Equal(t, c.height, height, c.name)
+	}
+}
+
+func TestText_Add_WhenRightToLeft(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len([]rune(value))) })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(20.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", consts.Family("dejavu")).Return(true)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("GetFont").Return(consts.Family("dejavu"), consts.Normal, 5.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("שלום 123", props.Text{Family: "dejavu", Size: 5.0, Align: consts.Right, Direction: consts.RightToLeft}, 0.0, 0, 1)
+	text.Add("لا", props.Text{Family: "dejavu", Size: 5.0, Align: consts.Left, Direction: consts.Auto}, 0.0, 0, 1)
+	text.Add("אבג", props.Text{Family: "dejavu", Size: 5.0, Direction: consts.Auto}, 5.0, 0, 1)
+
+	// Assert
+	_pdf.AssertNumberOfCalls(t, "UnicodeTranslatorFromDescriptor", 0)
+	_pdf.AssertCalled(t, "Text", 22.0, 10.0, "123 םולש")
+	_pdf.AssertCalled(t, "Text", 10.0, 10.0, "ﻻ")
+	// Without align, a text found right-to-left starts at the right
+	_pdf.AssertCalled(t, "Text", 27.0, 15.0, "גבא")
+}
+
+func TestText_Add_WhenRightToLeftAndFontIsntUTF8(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len(value)) })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+	_pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(text string) string {
+		return "\xe9t\xe9!"
+	})
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(20.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", mock.Anything).Return(false)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("GetFont").Return(consts.Arial, consts.Normal, 5.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("été!", props.Text{Family: consts.Arial, Size: 5.0, Direction: consts.RightToLeft}, 0.0, 0, 1)
+
+	// Assert
+	// The translated text isn't reordered, it is only aligned to the right
+	_pdf.AssertCalled(t, "Text", 26.0, 10.0, "\xe9t\xe9!")
+}
+
+func TestText_Add_WhenRightToLeftWithTabStops(t *testing.T) {
+	// Arrange
+	_pdf := &mocks.Pdf{}
+	_pdf.On("GetStringWidth", mock.Anything).Return(func(value string) float64 { return float64(len([]rune(value))) })
+	_pdf.On("GetMargins").Return(10.0, 10.0, 10.0, 10.0)
+	_pdf.On("Text", mock.Anything, mock.Anything, mock.Anything)
+	_pdf.On("SetTextColor", 0, 0, 0).Return(nil)
+
+	_math := &mocks.Math{}
+	_math.On("GetWidthPerCol", mock.Anything).Return(20.0)
+
+	_font := &mocks.Font{}
+	_font.On("IsUTF8", consts.Family("dejavu")).Return(true)
+	_font.On("GetScaleFactor").Return(1.0)
+	_font.On("GetFont").Return(consts.Family("dejavu"), consts.Normal, 5.0)
+	_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
+
+	text := internal.NewText(_pdf, _math, _font)
+
+	// Act
+	text.Add("שלום\t12", props.Text{
+		Family:    "dejavu",
+		Size:      5.0,
+		Direction: consts.RightToLeft,
+		TabStops:  []props.TabStop{{Position: 15.0, Align: consts.Left, Leader: "."}},
+	}, 0.0, 0, 1)
+
+	// Assert
+	// The parts are reordered and the stops are measured from the right boundary
+	_pdf.AssertCalled(t, "Text", 26.0, 10.0, "םולש")
+	_pdf.AssertCalled(t, "Text", 13.0, 10.0, "12")
+	_pdf.AssertCalled(t, "Text", 15.0, 10.0, "...........")
+}
+
 func TestText_Add(t *testing.T) {
 	cases := []struct {
 		name       string
@@ -141,6 +809,7 @@ func TestText_Add(t *testing.T) {
 			},
 			func() *mocks.Font {
 				_font := &mocks.Font{}
+				_font.On("IsUTF8", mock.Anything).Return(false)
 				_font.On("GetScaleFactor").Return(1.0)
 				_font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
 				_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
@@ -182,6 +851,7 @@ func TestText_Add(t *testing.T) {
 			},
 			func() *mocks.Font {
 				_font := &mocks.Font{}
+				_font.On("IsUTF8", mock.Anything).Return(false)
 				_font.On("GetScaleFactor").Return(1.0)
 				_font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
 				_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
@@ -224,6 +894,7 @@ func TestText_Add(t *testing.T) {
 			},
 			func() *mocks.Font {
 				_font := &mocks.Font{}
+				_font.On("IsUTF8", mock.Anything).Return(false)
 				_font.On("GetScaleFactor").Return(1.0)
 				_font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
 				_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
@@ -266,6 +937,7 @@ func TestText_Add(t *testing.T) {
 			},
 			func() *mocks.Font {
 				_font := &mocks.Font{}
+				_font.On("IsUTF8", mock.Anything).Return(false)
 				_font.On("GetScaleFactor").Return(1.0)
 				_font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
 				_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
@@ -309,6 +981,7 @@ func TestText_Add(t *testing.T) {
 			},
 			func() *mocks.Font {
 				_font := &mocks.Font{}
+				_font.On("IsUTF8", mock.Anything).Return(false)
 				_font.On("GetScaleFactor").Return(1.0)
 				_font.On("GetFont").Return(consts.Arial, consts.Bold, 1.0)
 				_font.On("SetFont", mock.Anything, mock.Anything, mock.Anything)
@@ -0,0 +1,13 @@
+package internal
+
+import (
+	"github.com/jung-kurt/gofpdf"
+)
+
+// rotate draw something rotated by angle degrees around (x, y)
+func rotate(pdf gofpdf.Pdf, angle, x, y float64, draw func()) {
+	pdf.TransformBegin()
+	pdf.TransformRotate(angle, x, y)
+	draw()
+	pdf.TransformEnd()
+}
@@ -32,6 +32,50 @@ const (
 	Bottom Align = "B"
 	// Middle represents a middle align (from gofpdf)
 	Middle Align = "M"
+	// DecimalPoint represents a tab stop align where the decimal separator of a number is at the stop
+	DecimalPoint Align = "D"
+)
+
+// Direction is a representation of a text direction
+type Direction string
+
+const (
+	// LeftToRight represents a left-to-right paragraph, right-to-left words inside it are reordered
+	LeftToRight Direction = "LTR"
+	// RightToLeft represents a right-to-left paragraph, used by Arabic, Hebrew and etc
+	RightToLeft Direction = "RTL"
+	// Auto represents a direction detected from the first strong character of the text
+	Auto Direction = "AUTO"
+)
+
+// ListType is a representation of the markers of a list
+type ListType string
+
+const (
+	// Bullet represents a list marked with a bullet glyph
+	Bullet ListType = "bullet"
+	// Decimal represents a list numbered with 1, 2, 3...
+	Decimal ListType = "decimal"
+	// LowerAlpha represents a list numbered with a, b, c...
+	LowerAlpha ListType = "lower-alpha"
+	// UpperAlpha represents a list numbered with A, B, C...
+	UpperAlpha ListType = "upper-alpha"
+	// LowerRoman represents a list numbered with i, ii, iii...
+	LowerRoman ListType = "lower-roman"
+	// UpperRoman represents a list numbered with I, II, III...
+	UpperRoman ListType = "upper-roman"
+)
+
+// WidthType is a representation of how the width of a column is defined
+type WidthType string
+
+const (
+	// Fixed represents a width in mm
+	Fixed WidthType = "fixed"
+	// Proportional represents a weight, the space left is shared by the weights of the columns
+	Proportional WidthType = "proportional"
+	// AutoFit represents a width measured from the widest text of the column
+	AutoFit WidthType = "auto-fit"
 )
 
 // Orientation is a representation of a page orientation
@@ -83,3 +127,55 @@ const (
 	// Png represents a png extension
 	Png Extension = "png"
 )
+
+// Aggregation is a representation of a calculation over the values of a column
+type Aggregation string
+
+const (
+	// Sum represents the sum of the values
+	Sum Aggregation = "sum"
+	// Average represents the average of the values
+	Average Aggregation = "average"
+	// Count represents the quantity of cells which aren't empty
+	Count Aggregation = "count"
+	// Min represents the smallest value
+	Min Aggregation = "min"
+	// Max represents the greatest value
+	Max Aggregation = "max"
+)
+
+// TableBorder is a representation of the lines drawn in a table
+type TableBorder string
+
+const (
+	// NoBorder represents a table without lines
+	NoBorder TableBorder = "none"
+	// HorizontalRules represents lines below the header and between the rows
+	HorizontalRules TableBorder = "horizontal"
+	// FullGrid represents lines around the table and between all cells
+	FullGrid TableBorder = "grid"
+	// OuterBox represents lines around the table
+	OuterBox TableBorder = "box"
+	// HeaderUnderline represents a line below the header
+	HeaderUnderline TableBorder = "header"
+)
+
+// Operator is a representation of a comparison between the text of a cell and a value
+type Operator string
+
+const (
+	// Equal represents a text equal to the value
+	Equal Operator = "=="
+	// NotEqual represents a text different from the value
+	NotEqual Operator = "!="
+	// Contains represents a text which contains the value
+	Contains Operator = "contains"
+	// LessThan represents a number less than the value
+	LessThan Operator = "<"
+	// LessOrEqual represents a number less than or equal to the value
+	LessOrEqual Operator = "<="
+	// GreaterThan represents a number greater than the value
+	GreaterThan Operator = ">"
+	// GreaterOrEqual represents a number greater than or equal to the value
+	GreaterOrEqual Operator = ">="
+)
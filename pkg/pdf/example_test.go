@@ -6,6 +6,8 @@ import (
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/pdf"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
+	"os"
+	"strconv"
 	"time"
 )
 
@@ -173,6 +175,120 @@ func ExamplePdfJustPdf_Text() {
 	// Do more things and save...
 }
 
+// ExamplePdfJustPdf_Text_fitting demonstrates how to fit a long
+// text inside a cell. MaxLines discards the remaining lines, Ellipsis
+// ends the cut text with "..." and ShrinkToFit reduces the font size
+// until the text fits, but never below MinSize.
+func ExamplePdfJustPdf_Text_fitting() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 10.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("A long description which does not fit in two lines of the cell", props.Text{
+				MaxLines: 2,
+				Ellipsis: true,
+			})
+		})
+		m.Col(func() {
+			m.Text("A long title which is reduced to fit the cell", props.Text{
+				Size:        14.0,
+				ShrinkToFit: true,
+				MinSize:     6.0,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Text_verticalAlign demonstrates how to align
+// the content of a cell vertically. Texts use VerticalAlign, while images,
+// QR codes and barcodes use the VerticalAlign of their props, which is
+// ignored when Center is true.
+func ExamplePdfJustPdf_Text_verticalAlign() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 20.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Top", props.Text{VerticalAlign: consts.Top})
+		})
+		m.Col(func() {
+			m.Text("Middle", props.Text{Align: consts.Center, VerticalAlign: consts.Middle})
+		})
+		m.Col(func() {
+			m.Text("Bottom", props.Text{Align: consts.Right, VerticalAlign: consts.Bottom})
+		})
+		m.Col(func() {
+			m.QrCode("https://github.com/muhammadmuhlas/just_pdf", props.Rect{
+				Percent:       50,
+				Align:         consts.Right,
+				VerticalAlign: consts.Bottom,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Text_rotation demonstrates how to rotate
+// the content of a cell. Rotation is the counter-clockwise angle in
+// degrees, the rotated text is wrapped to fit the cell and the rotated
+// images and codes are sized to fit inside it.
+func ExamplePdfJustPdf_Text_rotation() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 30.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Rotated header", props.Text{
+				Rotation:      90.0,
+				Align:         consts.Center,
+				VerticalAlign: consts.Middle,
+			})
+		})
+		m.Col(func() {
+			_ = m.Barcode("123456789", props.Barcode{
+				Center:   true,
+				Percent:  80,
+				Rotation: 90.0,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Text_decorations demonstrates how to decorate
+// a text with lines and how to control the spacing of its characters
+// and lines. LineHeight, when defined, replaces the font height plus
+// VerticalPadding.
+func ExamplePdfJustPdf_Text_decorations() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 15.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Underlined and overlined", props.Text{
+				Underline: true,
+				Overline:  true,
+			})
+		})
+		m.Col(func() {
+			m.Text("Old price", props.Text{StrikeThrough: true})
+		})
+		m.Col(func() {
+			m.Text("Spaced title with a line height of 6 mm", props.Text{
+				LetterSpacing: 0.5,
+				LineHeight:    6.0,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
 // ExamplePdfJustPdf_Signature demonstrates how to add
 // a Signature space inside a col. Passing nil on signatureProp make the method
 // use: arial Font, normal style and size 10.0.
@@ -234,6 +350,307 @@ func ExamplePdfJustPdf_TableList() {
 	// Do more things and save...
 }
 
+// ExamplePdfJustPdf_Table_content demonstrates how to add a table
+// with images, barcodes, QR codes and checkboxes in its cells
+func ExamplePdfJustPdf_Table_content() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	header := [][]props.TableCell{
+		{{Text: "Thumbnail"}, {Text: "SKU"}, {Text: "Packed"}, {Text: "Name"}},
+	}
+
+	// Each row is as high as its tallest cell, the
+	// image with 15mm
+	contents := [][]props.TableCell{
+		{
+			{Content: props.ImageCell{Base64: "base64string", Extension: consts.Jpg, Height: 15.0}},
+			{Content: props.BarcodeCell{Code: "7891234567895"}},
+			{Content: props.CheckboxCell{Checked: true}},
+			{Content: props.TextCell{Text: "Coffee", Prop: props.Text{Style: consts.Bold}}},
+		},
+	}
+
+	// An image or a barcode which can't be added leaves its cell
+	// empty, the error is returned after the whole table
+	_ = m.Table(header, contents)
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_footer demonstrates how to add a table
+// with a row of totals after the contents
+func ExamplePdfJustPdf_TableList_footer() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"Item", "Quantity", "Price"}
+	contents := [][]string{
+		{"Espresso", "2", "2.50"},
+		{"Cappuccino", "1", "3.00"},
+	}
+
+	// The last row has the quantity of items and the sum of the
+	// prices, when the table continues in the next page the totals
+	// until the end of the page are carried forward
+	m.TableList(headers, contents, props.TableList{
+		Footer: &props.TableFooter{
+			Cells: []props.FooterCell{
+				{Text: "Total"},
+				{Aggregation: consts.Count},
+				{Aggregation: consts.Sum},
+			},
+			CarriedForward: true,
+		},
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_styles demonstrates how to add a table
+// with styled rows and cells, and with rules applied to the cells
+// whose texts match a condition
+func ExamplePdfJustPdf_TableList_styles() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"Account", "Status", "Balance"}
+	contents := [][]string{
+		{"Savings", "Active", "1500.00"},
+		{"Checking", "Closed", "-20.00"},
+		{"Credit", "Active", "300.00"},
+	}
+
+	red := color.Color{Red: 200}
+	gray := color.Color{Red: 230, Green: 230, Blue: 230}
+
+	// The negative balances are red and the rows of the closed
+	// accounts are gray, the cells which are styled override
+	// the rows and the rules
+	m.TableList(headers, contents, props.TableList{
+		RowStyles: []props.CellStyle{
+			{Font: &props.Font{Style: consts.Bold}},
+		},
+		StyleRules: []props.StyleRule{
+			{Column: 2, Operator: consts.LessThan, Value: "0", Style: props.CellStyle{TextColor: &red}},
+			{Column: 1, Value: "Closed", Style: props.CellStyle{BackgroundColor: &gray}, Row: true},
+		},
+		CellStyles: [][]props.CellStyle{
+			nil, nil, {{}, {}, {Border: &props.Line{Width: 0.5}}},
+		},
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_borders demonstrates how to add a table
+// with lines around and between its cells
+func ExamplePdfJustPdf_TableList_borders() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"Item", "Quantity", "Price"}
+	contents := [][]string{
+		{"Espresso", "2", "2.50"},
+		{"Cappuccino", "1", "3.00"},
+	}
+
+	// All cells have gray lines around them, the
+	// table has a thicker line below it
+	m.TableList(headers, contents, props.TableList{
+		Border: consts.FullGrid,
+		BorderLine: props.Line{
+			Width: 0.3,
+			Color: color.Color{Red: 150, Green: 150, Blue: 150},
+		},
+		Borders: &props.Borders{
+			Bottom: &props.Line{Width: 0.8},
+		},
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableFromStructs demonstrates how to add a table
+// with a column to each field of a struct
+func ExamplePdfJustPdf_TableFromStructs() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	type customer struct {
+		Name string
+		City string
+	}
+
+	// The fields of Customer are the columns "Customer Name"
+	// and "Customer City", and Notes isn't added
+	type invoice struct {
+		Number   string `pdf:"header=No.,width=20"`
+		Customer customer
+		Amount   float64   `pdf:"align=R,format=%.2f"`
+		Due      time.Time `pdf:"format=02/01/2006"`
+		Notes    string    `pdf:"-"`
+	}
+
+	invoices := []invoice{
+		{"A-1", customer{"Alice", "Lisbon"}, 12.5, time.Now(), ""},
+		{"A-2", customer{"Bob", "Porto"}, 7.25, time.Now(), ""},
+	}
+
+	err := m.TableFromStructs(invoices)
+	if err != nil {
+		return
+	}
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableFromCSV demonstrates how to add a table
+// with the records of a CSV, drawn while they are read
+func ExamplePdfJustPdf_TableFromCSV() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	file, err := os.Open("path/export.csv")
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	// The first record is the header, which is
+	// repeated in each page
+	err = m.TableFromCSV(file, props.TableCSV{
+		Table: props.TableList{RepeatHeader: true},
+	})
+	if err != nil {
+		return
+	}
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableFromIterator demonstrates how to add a table
+// with contents which are drawn while they are read
+func ExamplePdfJustPdf_TableFromIterator() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	row := 0
+
+	// Each content is read only when the previous is drawn,
+	// until next returns false
+	next := func() ([]string, bool) {
+		if row == 50000 {
+			return nil, false
+		}
+
+		row++
+		return []string{strconv.Itoa(row), "Coffee"}, true
+	}
+
+	m.TableFromIterator([]string{"Number", "Product"}, next)
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_group demonstrates how to add a table
+// with the contents grouped by a key, with subtotals of each group
+func ExamplePdfJustPdf_TableList_group() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"Region", "Customer", "Amount"}
+	contents := [][]string{
+		{"North", "Alice", "10.00"},
+		{"North", "Bob", "5.50"},
+		{"South", "Carol", "7.25"},
+	}
+
+	// The contents next to each other with the same region are a group,
+	// with a title above them and the sum of the amounts after them
+	m.TableList(headers, contents, props.TableList{
+		Group: &props.TableGroup{
+			Key: func(content []string) string {
+				return content[0]
+			},
+			Header: func(key string) string {
+				return "Region: " + key
+			},
+			Footer: func(key string) []string {
+				return []string{"Subtotal " + key}
+			},
+			Subtotals: []props.FooterCell{{}, {}, {Aggregation: consts.Sum}},
+		},
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Table demonstrates how to add a table
+// with merged cells and a header with two rows
+func ExamplePdfJustPdf_Table() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	// Q1 is above Jan, Feb and Mar, Product
+	// occupies both rows of the header
+	header := [][]props.TableCell{
+		{{Text: "Product", RowSpan: 2}, {Text: "Q1", ColSpan: 3}},
+		{{Text: "Jan"}, {Text: "Feb"}, {Text: "Mar"}},
+	}
+
+	// Coffee is at the left of two rows
+	contents := [][]props.TableCell{
+		{{Text: "Coffee", RowSpan: 2}, {Text: "10"}, {Text: "12"}, {Text: "9"}},
+		{{Text: "8"}, {Text: "7"}, {Text: "11"}},
+		{{Text: "Tea"}, {Text: "5"}, {Text: "6"}, {Text: "4"}},
+	}
+
+	m.Table(header, contents, props.TableList{
+		Line: true,
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_repeatHeader demonstrates how to add a table
+// which has its header in every page
+func ExamplePdfJustPdf_TableList_repeatHeader() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"Header1", "Header2"}
+	contents := [][]string{}
+
+	// Contents enough to fill more than one page
+	for i := 0; i < 100; i++ {
+		contents = append(contents, []string{"Content1", "Content2"})
+	}
+
+	// The header is added again after a page break,
+	// with "(continued)" above it
+	m.TableList(headers, contents, props.TableList{
+		RepeatHeader:     true,
+		ContinuedCaption: "(continued)",
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_TableList_columnWidths demonstrates how to add a table
+// with a narrow ID column and a wide description column
+func ExamplePdfJustPdf_TableList_columnWidths() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	headers := []string{"ID", "Description", "Price"}
+	contents := [][]string{
+		{"1", "Espresso with a dash of steamed milk", "2.50"},
+		{"2", "Cappuccino", "3.00"},
+	}
+
+	// ID is sized from its widest text, Description is
+	// three times wider than Price
+	m.TableList(headers, contents, props.TableList{
+		ColumnWidths: []props.ColumnWidth{
+			{Type: consts.AutoFit, Min: 10.0, Max: 20.0},
+			{Type: consts.Proportional, Value: 3.0},
+			{Type: consts.Proportional, Value: 1.0},
+		},
+	})
+
+	// Do more things and save...
+}
+
 // ExamplePdfJustPdf_FileImage demonstrates how add an Image
 // reading from disk.
 // When props.Rect is nil, method make Image fulfill the context
@@ -423,6 +840,66 @@ func ExamplePdfJustPdf_RegisterHeader() {
 	// Do more things or not and save...
 }
 
+// ExamplePdfJustPdf_RegisterRepeatedHeader demonstrates how to repeat Rows
+// at the top of every new page, after the header, until the repetition
+// is stopped with a nil closure.
+func ExamplePdfJustPdf_RegisterRepeatedHeader() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.RegisterRepeatedHeader(func() {
+		m.Row(10, func() {
+			m.Col(func() {
+				m.Text("Continued from the previous page")
+			})
+		})
+	})
+
+	// Add the Rows which continue in the next pages...
+
+	m.RegisterRepeatedHeader(nil)
+
+	// Do more things or not and save...
+}
+
+// ExamplePdfJustPdf_KeepWithNext demonstrates how to keep a title in the
+// same page as the Row after it, and how to start a new page.
+func ExamplePdfJustPdf_KeepWithNext() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.KeepWithNext(10, func() {
+		m.Row(10, func() {
+			m.Col(func() {
+				m.Text("Title", props.Text{Style: consts.Bold})
+			})
+		})
+	})
+
+	m.Row(20, func() {
+		m.Col(func() {
+			m.Text("lorem ipsum dolor")
+		})
+	})
+
+	m.AddPage()
+
+	// Do more things or not and save...
+}
+
+// ExamplePdfJustPdf_DrawLine demonstrates how to draw a line and fill a
+// rectangle inside a Row, with x from the left margin and y from the top of the Row.
+func ExamplePdfJustPdf_DrawLine() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.Row(20, func() {
+		m.Col(func() {
+			m.FillRect(0, 0, 50, 20, color.Color{Red: 230, Green: 230, Blue: 230})
+			m.DrawLine(0, 20, 50, 20, props.Line{Width: 0.5})
+		})
+	})
+
+	// Do more things or not and save...
+}
+
 // ExamplePdfJustPdf_SetPageMargins demonstrates how to set custom page margins.
 func ExamplePdfJustPdf_SetPageMargins() {
 	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
@@ -493,3 +970,352 @@ func ExamplePdfJustPdf_GetPageMargins() {
 
 	// Do more things and save...
 }
+
+// ExamplePdfJustPdf_AddUTF8Font demonstrates how to register an UTF-8 font
+// and write a right-to-left text with it.
+func ExamplePdfJustPdf_AddUTF8Font() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.AddUTF8Font("dejavu", consts.Normal, "path/DejaVuSans.ttf")
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("مرحبا بالعالم", props.Text{
+				Family:    "dejavu",
+				Direction: consts.RightToLeft,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Anchor demonstrates how to mark a position
+// which internal links can point to.
+func ExamplePdfJustPdf_Anchor() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Go to details", props.Text{
+				Link: props.Link{Anchor: "details"},
+			})
+		})
+	})
+
+	// Add more rows...
+
+	m.Anchor("details")
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Details")
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Link demonstrates how to make a cell clickable.
+func ExamplePdfJustPdf_Link() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Visit the website")
+			m.Link(props.Link{URL: "https://github.com/muhammadmuhlas/just_pdf"})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Bookmark demonstrates how to add entries
+// to the outline of the PDF viewers.
+func ExamplePdfJustPdf_Bookmark() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Bookmark("Chapter 1", 0)
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Chapter 1")
+		})
+	})
+
+	m.Bookmark("Section 1.1", 1)
+
+	// Add more rows...
+
+	// Do more things and save...
+}
+
+// ExampleRender demonstrates how to create a document which
+// has a table of contents. The closure is executed more than
+// once, until the page numbers are stable.
+func ExampleRender() {
+	m := pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+		rowHeight := 5.0
+
+		m.TableOfContents()
+
+		for chapter := 1; chapter <= 3; chapter++ {
+			title := fmt.Sprintf("Chapter %d", chapter)
+
+			m.Bookmark(title, 0)
+			m.Row(rowHeight, func() {
+				m.Col(func() {
+					m.Text(title)
+				})
+			})
+		}
+	})
+
+	// Do more things and save...
+	_ = m.OutputFileAndClose("path/file.pdf")
+}
+
+// ExamplePdfJustPdf_TableOfContents demonstrates how to list
+// the bookmarks with page numbers and dot leaders.
+func ExamplePdfJustPdf_TableOfContents() {
+	m := pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+		m.TableOfContents(props.TableOfContents{
+			Font: props.Font{
+				Family: consts.Helvetica,
+				Size:   11.0,
+			},
+			RowHeight: 8.0,
+			Indent:    4.0,
+			Leader:    ".",
+			Levels:    2,
+		})
+
+		// Add bookmarks and rows...
+	})
+
+	// Do more things and save...
+	_ = m.OutputFileAndClose("path/file.pdf")
+}
+
+// ExamplePdfJustPdf_Heading demonstrates how to add numbered
+// headings, which are also bookmarks.
+func ExamplePdfJustPdf_Heading() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.Heading(0, "Introduction") // 1 Introduction
+	m.Heading(1, "Scope")        // 1.1 Scope
+	m.Heading(1, "Audience")     // 1.2 Audience
+
+	m.Heading(0, "Appendix", props.Heading{
+		Font: props.Font{
+			Family: consts.Helvetica,
+			Style:  consts.Bold,
+			Size:   14.0,
+		},
+		Color:      color.Color{Red: 50, Green: 50, Blue: 150},
+		RowHeight:  12.0,
+		Unnumbered: true,
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_SetHeadingStyle demonstrates how to define
+// the style of the headings from a level.
+func ExamplePdfJustPdf_SetHeadingStyle() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.SetHeadingStyle(0, props.Heading{
+		Font: props.Font{
+			Family: consts.Courier,
+			Size:   20.0,
+		},
+	})
+
+	m.Heading(0, "Introduction")
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_List demonstrates how to add a list
+// with nested items.
+func ExamplePdfJustPdf_List() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.List([]props.ListItem{
+		{Text: "Fruits", Items: []props.ListItem{
+			{Text: "Apple"},
+			{Text: "Banana"},
+		}},
+		{Text: "Vegetables"},
+	})
+
+	m.List([]props.ListItem{
+		{Text: "Install the package"},
+		{Text: "Build the document"},
+	}, props.List{
+		Font: props.Font{
+			Family: consts.Helvetica,
+			Size:   11.0,
+		},
+		Type:        consts.LowerRoman,
+		Indent:      8.0,
+		MarkerWidth: 8.0,
+		ItemSpacing: 2.0,
+	})
+
+	m.List([]props.ListItem{
+		{Text: "Marked with a ZapfDingbats check"},
+	}, props.List{
+		Bullet:       "4",
+		BulletFamily: consts.ZapBats,
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Footnote demonstrates how to reference
+// a note at the bottom of the page from a text.
+func ExamplePdfJustPdf_Footnote() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Revenue grew 12%"+m.Footnote("Audited figures."), props.Text{
+				Top: 4.0,
+			})
+		})
+	})
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Costs were stable"+m.Footnote("Excluding taxes.", props.Footnote{
+				Font: props.Font{
+					Family: consts.Helvetica,
+					Style:  consts.Italic,
+					Size:   7.0,
+				},
+			}), props.Text{
+				Top: 4.0,
+			})
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Endnotes demonstrates how to collect notes
+// and write them at the end of a section.
+func ExamplePdfJustPdf_Endnotes() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("See the sources"+m.Footnote("Annual report.", props.Footnote{
+				Endnote: true,
+			}), props.Text{
+				Top: 4.0,
+			})
+		})
+	})
+
+	// End of the section
+	m.Endnotes()
+
+	// Do more things and save...
+}
+
+// ExamplePdfJustPdf_Text_crossReference demonstrates how to refer
+// to the page and to the section of an anchor, which are filled
+// in when the document is finished.
+func ExamplePdfJustPdf_Text_crossReference() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("See section {section:pricing} on page {ref:pricing}", props.Text{
+				Top:  4.0,
+				Link: props.Link{Anchor: "pricing"},
+			})
+		})
+	})
+
+	// Add more rows...
+
+	m.Heading(0, "Pricing")
+	m.Anchor("pricing")
+
+	// Do more things and save...
+	_ = m.OutputFileAndClose("path/file.pdf")
+}
+
+// ExamplePdfJustPdf_Index demonstrates how to mark the terms
+// of an alphabetical index and add it at the end of the document.
+func ExamplePdfJustPdf_Index() {
+	m := pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+		m.IndexTerm("Table", "")
+		m.IndexTerm("Table", "borders")
+
+		// Add rows and more terms...
+
+		m.Heading(0, "Index")
+		m.Index(props.Index{
+			Font: props.Font{
+				Family: consts.Helvetica,
+				Size:   9.0,
+			},
+			RowHeight: 4.5,
+			Indent:    3.0,
+			ColumnGap: 10.0,
+		})
+	})
+
+	// Do more things and save...
+	_ = m.OutputFileAndClose("path/file.pdf")
+}
+
+// ExamplePdfJustPdf_Text_tabStops demonstrates how to align the parts
+// of a text separated by tab characters, filling the space with dots.
+func ExamplePdfJustPdf_Text_tabStops() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 6.0
+
+	prices := props.Text{
+		Top: 4.0,
+		TabStops: []props.TabStop{
+			{Position: 60.0, Align: consts.Left, Leader: "."},
+			{Position: 90.0, Align: consts.DecimalPoint},
+		},
+	}
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("Espresso\t30 ml\t2.50", prices)
+		})
+	})
+
+	// Do more things and save...
+}
+
+// ExampleSubscript demonstrates how to write superscript,
+// subscript and small caps parts of a text.
+func ExampleSubscript() {
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	rowHeight := 5.0
+
+	m.Row(rowHeight, func() {
+		m.Col(func() {
+			m.Text("H"+pdf.Subscript("2")+"O is "+pdf.SmallCaps("water"), props.Text{Top: 4.0})
+		})
+		m.Col(func() {
+			m.Text("The 1"+pdf.Superscript("st")+" place", props.Text{Top: 4.0})
+		})
+	})
+
+	// Do more things and save...
+}
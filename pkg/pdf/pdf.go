@@ -2,9 +2,19 @@ package pdf
 
 import (
 	"bytes"
-	"github.com/muhammadmuhlas/just_pdf/pkg/color"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
 
 	"github.com/muhammadmuhlas/just_pdf/internal"
+	"github.com/muhammadmuhlas/just_pdf/pkg/color"
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/props"
 	"github.com/jung-kurt/gofpdf"
@@ -17,10 +27,14 @@ type JustPdf interface {
 	Col(closure func())
 	ColSpace()
 	ColSpaces(qtd int)
+	KeepWithNext(height float64, closure func())
+	AddPage()
 
 	// Registers
 	RegisterHeader(closure func())
 	RegisterFooter(closure func())
+	RegisterRepeatedHeader(closure func())
+	RegisterRepeatedFooter(closure func())
 
 	// Helpers
 	SetBorder(on bool)
@@ -33,9 +47,23 @@ type JustPdf interface {
 	SetPageMargins(left, top, right, bottom float64)
 	SetLRMargins(left, right float64)
 	GetPageMargins() (float64, float64, float64, float64)
+	AddUTF8Font(family consts.Family, style consts.Style, filePathName string)
+	Anchor(name string)
+	Bookmark(title string, level int)
+	IndexTerm(term, subterm string)
+	SetHeadingStyle(level int, prop props.Heading)
 
 	// Outside Col/Row Components
 	TableList(header []string, contents [][]string, prop ...props.TableList)
+	Table(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) error
+	TableFromStructs(slice interface{}, prop ...props.TableList) error
+	TableFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList)
+	TableFromCSV(reader io.Reader, prop ...props.TableCSV) error
+	List(items []props.ListItem, prop ...props.List)
+	TableOfContents(prop ...props.TableOfContents)
+	Index(prop ...props.Index)
+	Heading(level int, text string, prop ...props.Heading)
+	Endnotes()
 	Line(spaceHeight float64)
 	VLine(spaceWidht, spaceHeight float64, color color.Color)
 
@@ -46,6 +74,10 @@ type JustPdf interface {
 	Barcode(code string, prop ...props.Barcode) error
 	QrCode(code string, prop ...props.Rect)
 	Signature(label string, prop ...props.Font)
+	Link(link props.Link)
+	Footnote(text string, prop ...props.Footnote) string
+	DrawLine(x1, y1, x2, y2 float64, prop ...props.Line)
+	FillRect(x, y, width, height float64, color color.Color)
 
 	// File System
 	OutputFileAndClose(filePathName string) error
@@ -62,6 +94,7 @@ type PdfJustPdf struct {
 	Image                     internal.Image
 	Code                      internal.Code
 	TableListHelper           internal.TableList
+	ListHelper                internal.List
 	pageIndex                 int
 	offsetY                   float64
 	rowHeight                 float64
@@ -71,14 +104,221 @@ type PdfJustPdf struct {
 	colsClosures              []func()
 	headerClosure             func()
 	footerClosure             func()
+	repeatedHeaderClosure     func()
+	repeatedFooterClosure     func()
+	repeatedFooterHeight      float64
 	footerHeight              float64
 	headerFooterContextActive bool
+	rowContextActive          bool
+	anchors                   map[string]int
+	references                map[string]reference
+	deferredTexts             []deferredText
+	deferredCellTexts         []deferredCellText
+	bookmarks                 []bookmark
+	indexTerms                []indexTerm
+	pendingMarks              []func()
+	previousLayout            *layout
+	headingStyles             map[int]props.Heading
+	headingNumbers            []int
+	keptRows                  []keptRow
+	rowCount                  int
+	rowIndex                  int
+	movedRows                 []int
+	footnotes                 []footnote
+	nextFootnotes             []footnote
+	endnotes                  []footnote
+	footnoteCount             int
+	endnoteCount              int
 	calculationMode           bool
 	debugMode                 bool
 	orientation               consts.Orientation
 	pageSize                  consts.PageSize
 }
 
+// bookmark is an outline entry, with the position where it was marked
+type bookmark struct {
+	title   string
+	level   int
+	page    int
+	offsetY float64
+}
+
+// indexTerm is a term of the Index, with the page where it was marked
+type indexTerm struct {
+	term    string
+	subterm string
+	page    int
+}
+
+// indexLine is a line of the Index, a letter or a term with its pages
+type indexLine struct {
+	text   string
+	letter bool
+	indent bool
+}
+
+// keptRow is a Row which is only added with the next Row, to keep both in the same page
+type keptRow struct {
+	height  float64
+	closure func()
+}
+
+// footnote is a note with the mark which references it, and the height of its Row
+type footnote struct {
+	mark   string
+	text   string
+	prop   props.Footnote
+	height float64
+}
+
+// reference is what a cross-reference knows about an anchor
+type reference struct {
+	page   int
+	number string
+}
+
+// cellPosition is the place of a column inside a Row, where a Text is written
+type cellPosition struct {
+	offsetY   float64
+	height    float64
+	actualCol float64
+	qtdCols   float64
+	width     float64
+}
+
+// deferredText is a Text with cross-references, which is written when the
+// document is finished, at the position and with the margins of its cell
+type deferredText struct {
+	text  string
+	prop  props.Text
+	page  int
+	cell  cellPosition
+	left  float64
+	right float64
+}
+
+// deferredCellText is a text with cross-references written by a component, like TableList
+// and List, which is written when the document is finished with the margins of its cell
+type deferredCellText struct {
+	text      string
+	prop      props.Text
+	page      int
+	marginTop float64
+	actualCol float64
+	qtdCols   float64
+	left      float64
+	right     float64
+}
+
+// referenceText is the Text of the components which write its texts by themselves, like TableList
+// and List, so their texts with cross-references are written when the document is finished
+type referenceText struct {
+	internal.Text
+	justPdf *PdfJustPdf
+}
+
+// Add write a text, the texts with cross-references are recorded with the margins of its cell
+func (s *referenceText) Add(text string, textProp props.Text, marginTop float64, actualCol float64, qtdCols float64) {
+	text = s.justPdf.resolvePreviousReferences(text)
+
+	if !referencePattern.MatchString(text) {
+		s.Text.Add(text, textProp, marginTop, actualCol, qtdCols)
+		return
+	}
+
+	left, _, right, _ := s.justPdf.Pdf.GetMargins()
+
+	s.justPdf.deferredCellTexts = append(s.justPdf.deferredCellTexts, deferredCellText{
+		text:      text,
+		prop:      textProp,
+		page:      s.justPdf.pageIndex + 1,
+		marginTop: marginTop,
+		actualCol: actualCol,
+		qtdCols:   qtdCols,
+		left:      left,
+		right:     right,
+	})
+}
+
+// AddRotated write a rotated text, with the cross-references known by the previous pass of Render
+func (s *referenceText) AddRotated(text string, textProp props.Text, marginTop float64, actualCol float64, qtdCols float64, colHeight float64) {
+	s.Text.AddRotated(s.justPdf.resolvePreviousReferences(text), textProp, marginTop, actualCol, qtdCols, colHeight)
+}
+
+// GetLinesQuantity measure a text with the cross-references known by the previous pass of Render
+func (s *referenceText) GetLinesQuantity(text string, textProp props.Text, qtdCols float64) int {
+	return s.Text.GetLinesQuantity(s.justPdf.resolvePreviousReferences(text), textProp, qtdCols)
+}
+
+// GetFitSize measure a text with the cross-references known by the previous pass of Render
+func (s *referenceText) GetFitSize(text string, textProp props.Text, qtdCols float64, height float64) float64 {
+	return s.Text.GetFitSize(s.justPdf.resolvePreviousReferences(text), textProp, qtdCols, height)
+}
+
+// GetStringWidth measure a text with the cross-references known by the previous pass of Render
+func (s *referenceText) GetStringWidth(text string, textProp props.Text) float64 {
+	return s.Text.GetStringWidth(s.justPdf.resolvePreviousReferences(text), textProp)
+}
+
+// GetLinesHeight measure a text with the cross-references known by the previous pass of Render
+func (s *referenceText) GetLinesHeight(text string, textProp props.Text, qtdCols float64) float64 {
+	return s.Text.GetLinesHeight(s.justPdf.resolvePreviousReferences(text), textProp, qtdCols)
+}
+
+// GetRotatedHeight measure a text with the cross-references known by the previous pass of Render
+func (s *referenceText) GetRotatedHeight(text string, textProp props.Text, qtdCols float64) float64 {
+	return s.Text.GetRotatedHeight(s.justPdf.resolvePreviousReferences(text), textProp, qtdCols)
+}
+
+// layout is what a render pass knows about the document after the pagination,
+// used by the next pass to draw what depends on it
+type layout struct {
+	bookmarks  []bookmark
+	indexTerms []indexTerm
+	movedRows  []int
+	references map[string]reference
+}
+
+// maxRenderPasses limit how many times Render execute the closure
+const maxRenderPasses = 4
+
+// defaultHeadingSizes are the font sizes of the default heading styles, deeper levels use the last one
+var defaultHeadingSizes = []float64{18.0, 15.0, 13.0, 11.0}
+
+// bookmarkAnchorPrefix is the prefix of the anchors which TableOfContents entries point to
+const bookmarkAnchorPrefix = "_bookmark_"
+
+// referencePattern match the cross-references inside a text, ex: {ref:pricing} and {section:pricing}
+var referencePattern = regexp.MustCompile(`\{(ref|section):([^{}]+)\}`)
+
+// pageRangeSeparator is written between the first and the last page of a range in the Index
+const pageRangeSeparator = "\u2013"
+
+// unknownReference replace a cross-reference to an anchor which doesn't exist
+const unknownReference = "??"
+
+const (
+	// footnoteSeparatorHeight is the space of the line between the body and the footnotes
+	footnoteSeparatorHeight = 3.0
+	// footnoteSpacing is the space after each footnote
+	footnoteSpacing = 1.0
+)
+
+// Superscript mark a part of a text to be written smaller and raised, ex: m.Text("E = mc" + pdf.Superscript("2"))
+func Superscript(text string) string {
+	return internal.Superscript(text)
+}
+
+// Subscript mark a part of a text to be written smaller and lowered, ex: m.Text("H" + pdf.Subscript("2") + "O")
+func Subscript(text string) string {
+	return internal.Subscript(text)
+}
+
+// SmallCaps mark a part of a text to have its lower case letters written as smaller capitals
+func SmallCaps(text string) string {
+	return internal.SmallCaps(text)
+}
+
 // NewJustPdf create a JustPdf instance returning a pointer to PdfJustPdf
 // Receive an Orientation and a PageSize.
 func NewJustPdf(orientation consts.Orientation, pageSize consts.PageSize) JustPdf {
@@ -95,7 +335,12 @@ func NewJustPdf(orientation consts.Orientation, pageSize consts.PageSize) JustPd
 
 	code := internal.NewCode(fpdf, math)
 
-	tableList := internal.NewTableList(text, font)
+	// The components which write its texts by themselves resolve the cross-references too
+	cellText := &referenceText{Text: text}
+
+	tableList := internal.NewTableList(cellText, font, image, code)
+
+	list := internal.NewList(cellText, font)
 
 	justPdf := &PdfJustPdf{
 		Pdf:             fpdf,
@@ -106,13 +351,17 @@ func NewJustPdf(orientation consts.Orientation, pageSize consts.PageSize) JustPd
 		Image:           image,
 		Code:            code,
 		TableListHelper: tableList,
+		ListHelper:      list,
 		pageSize:        pageSize,
 		orientation:     orientation,
 		calculationMode: false,
 		backgroundColor: color.NewWhite(),
 	}
 
+	cellText.justPdf = justPdf
+
 	justPdf.TableListHelper.BindGrid(justPdf)
+	justPdf.ListHelper.BindGrid(justPdf)
 
 	justPdf.Font.SetFamily(consts.Arial)
 	justPdf.Font.SetStyle(consts.Bold)
@@ -124,6 +373,33 @@ func NewJustPdf(orientation consts.Orientation, pageSize consts.PageSize) JustPd
 	return justPdf
 }
 
+// Render create a JustPdf instance executing the closure, which builds the whole document,
+// until the pagination is stable. Components which depend on the pagination, like
+// TableOfContents, are drawn with the page numbers from the previous execution.
+// The closure must not have side effects, because it is executed more than once
+func Render(orientation consts.Orientation, pageSize consts.PageSize, closure func(m JustPdf)) JustPdf {
+	var previous *layout
+	var justPdf *PdfJustPdf
+
+	for pass := 0; pass < maxRenderPasses; pass++ {
+		justPdf = NewJustPdf(orientation, pageSize).(*PdfJustPdf)
+		justPdf.previousLayout = previous
+
+		closure(justPdf)
+		justPdf.addKeptRows(0)
+		justPdf.resolvePendingMarks()
+
+		current := justPdf.getLayout()
+		if previous != nil && reflect.DeepEqual(previous, current) {
+			break
+		}
+
+		previous = current
+	}
+
+	return justPdf
+}
+
 // RegisterHeader define a sequence of Rows, Lines ou TableLists
 // which will be added in every new page
 func (s *PdfJustPdf) RegisterHeader(closure func()) {
@@ -142,6 +418,35 @@ func (s *PdfJustPdf) RegisterFooter(closure func()) {
 	s.calculationMode = false
 }
 
+// RegisterRepeatedHeader define a sequence of Rows which will be added in every
+// new page after the header registered by RegisterHeader, ex: the header of a
+// table which continues in the next page. A nil closure stops the repetition
+func (s *PdfJustPdf) RegisterRepeatedHeader(closure func()) {
+	s.repeatedHeaderClosure = closure
+}
+
+// RegisterRepeatedFooter define a sequence of Rows which will be added at the end
+// of every page before the footer registered by RegisterFooter, ex: the subtotals
+// of a table which continues in the next page. A nil closure stops the repetition
+func (s *PdfJustPdf) RegisterRepeatedFooter(closure func()) {
+	// The space of the previous repeated footer is released
+	s.footerHeight -= s.repeatedFooterHeight
+	s.repeatedFooterHeight = 0
+	s.repeatedFooterClosure = closure
+
+	if closure == nil {
+		return
+	}
+
+	// calculation mode execute all row flow but
+	// only to calculate the sum of heights
+	footerHeight := s.footerHeight
+	s.calculationMode = true
+	closure()
+	s.calculationMode = false
+	s.repeatedFooterHeight = s.footerHeight - footerHeight
+}
+
 // GetCurrentPage obtain the current page index
 // this can be used inside a RegisterFooter/RegisterHeader
 // to draw the current page, or to another purposes
@@ -178,6 +483,13 @@ func (s *PdfJustPdf) GetPageMargins() (left float64, top float64, right float64,
 	return s.Pdf.GetMargins()
 }
 
+// AddUTF8Font register a TrueType font file which can be used by any component
+// through its Family, e.g. to write Arabic or Hebrew texts. Each style used
+// (consts.Normal, consts.Bold and etc) must be registered with its own file
+func (s *PdfJustPdf) AddUTF8Font(family consts.Family, style consts.Style, filePathName string) {
+	s.Font.AddUTF8Font(family, style, filePathName)
+}
+
 // Signature add a space for a signature inside a cell,
 // the space will have a line and a text below
 func (s *PdfJustPdf) Signature(label string, prop ...props.Font) {
@@ -203,6 +515,70 @@ func (s *PdfJustPdf) TableList(header []string, contents [][]string, prop ...pro
 	s.Pdf.PageCount()
 }
 
+// Table create a table like TableList, with cells which can be merged with
+// the next columns by ColSpan and with the next rows by RowSpan. The header
+// can have many rows, ex: "Q1" above "Jan", "Feb" and "Mar". An image or a barcode
+// which can't be added leaves its cell empty and the first error is returned.
+func (s *PdfJustPdf) Table(header [][]props.TableCell, contents [][]props.TableCell, prop ...props.TableList) error {
+	return s.TableListHelper.CreateCells(header, contents, prop...)
+}
+
+// TableFromStructs create a TableList from a slice of structs, with a column to each
+// exported field. The tag "pdf" changes the columns, ex: `pdf:"header=Amount,align=R,format=%.2f,width=20"`,
+// and `pdf:"-"` skips a field. The fields of nested structs are columns too, and the fields
+// which are fmt.Stringer or time.Time, formatted by its layout, are written in one column.
+func (s *PdfJustPdf) TableFromStructs(slice interface{}, prop ...props.TableList) error {
+	return s.TableListHelper.CreateFromStructs(slice, prop...)
+}
+
+// TableFromIterator create a TableList with the contents returned by next until it returns false.
+// The contents are drawn while they are read, so they aren't kept in memory, and the widths of
+// the columns are known only from the header. When the header is nil the first contents are the header.
+func (s *PdfJustPdf) TableFromIterator(header []string, next func() ([]string, bool), prop ...props.TableList) {
+	s.TableListHelper.CreateFromIterator(header, next, prop...)
+}
+
+// TableFromCSV create a TableList with the records of a CSV, which are drawn while they are read
+// like TableFromIterator. When props.TableCSV has no Header the first record is the header.
+func (s *PdfJustPdf) TableFromCSV(reader io.Reader, prop ...props.TableCSV) error {
+	csvProp := props.TableCSV{}
+
+	if len(prop) > 0 {
+		csvProp = prop[0]
+	}
+
+	csvProp.MakeValid()
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = csvProp.Comma
+	// The records can have different quantities of fields, like the contents of a TableList
+	csvReader.FieldsPerRecord = -1
+
+	var err error
+
+	s.TableListHelper.CreateFromIterator(csvProp.Header, func() ([]string, bool) {
+		record, readErr := csvReader.Read()
+		if readErr != nil {
+			if readErr != io.EOF {
+				err = readErr
+			}
+
+			return nil, false
+		}
+
+		return record, true
+	}, csvProp.Table)
+
+	return err
+}
+
+// List create a bullet or numbered list, with one Row to each item.
+// Nested items are indented below its parent, and wrapped lines
+// are aligned with the text, after the marker.
+func (s *PdfJustPdf) List(items []props.ListItem, prop ...props.List) {
+	s.ListHelper.Create(items, prop...)
+}
+
 // SetBorder enable the draw of lines in every cell.
 // Draw borders in all columns created.
 func (s *PdfJustPdf) SetBorder(on bool) {
@@ -232,6 +608,25 @@ func (s *PdfJustPdf) GetPageSize() (float64, float64) {
 	return s.Pdf.GetPageSize()
 }
 
+// KeepWithNext add a sequence of Rows with the height later, in the page which has space
+// to them and to the next Row, so they are never the last Rows of a page, ex: a title
+func (s *PdfJustPdf) KeepWithNext(height float64, closure func()) {
+	s.keptRows = append(s.keptRows, keptRow{
+		height:  height,
+		closure: closure,
+	})
+}
+
+// AddPage add the footer and start a new page, the
+// current page is kept when it has no Rows yet
+func (s *PdfJustPdf) AddPage() {
+	if s.offsetY == 0 {
+		return
+	}
+
+	s.breakPage()
+}
+
 // Line draw a line from margin left to margin right
 // in the currently row.
 func (s *PdfJustPdf) Line(spaceHeight float64) {
@@ -262,10 +657,26 @@ func (s *PdfJustPdf) Row(height float64, closure func()) {
 		return
 	}
 
+	// The Rows of the body are counted, so the Rows moved by the previous pass are known
+	index := s.rowCount
+
+	if !s.headerFooterContextActive {
+		s.rowCount++
+
+		// A Row whose footnotes didn't fit in the page in the previous pass starts the next page
+		if s.isMovedRow(index) {
+			s.addMovedRow(index)
+			s.AddPage()
+		}
+
+		// Kept Rows are added with this Row, moving all to the next page when needed
+		s.addKeptRows(height)
+	}
+
 	_, pageHeight := s.Pdf.GetPageSize()
 	_, top, _, bottom := s.Pdf.GetMargins()
 
-	totalOffsetY := int(s.offsetY + height + s.footerHeight)
+	totalOffsetY := int(s.offsetY + height + s.footerHeight + s.getFootnotesHeight(s.footnotes))
 	maxOffsetPage := int(pageHeight - bottom - top)
 
 	// Note: The headerFooterContextActive is needed to avoid recursive
@@ -276,6 +687,8 @@ func (s *PdfJustPdf) Row(height float64, closure func()) {
 	// height of the footer, add the footer
 	if totalOffsetY > maxOffsetPage {
 		if !s.headerFooterContextActive {
+			s.drawFootnotes()
+			s.drawRepeatedFooter()
 			if s.footerClosure != nil {
 				s.headerFooterContextActive = true
 				s.footerClosure()
@@ -283,21 +696,27 @@ func (s *PdfJustPdf) Row(height float64, closure func()) {
 			}
 			s.offsetY = 0
 			s.pageIndex++
+			s.moveFootnotesToPage()
 		}
 	}
 
 	// If is a new page, add the header
-	if !s.headerFooterContextActive && s.headerClosure != nil {
-		if s.offsetY == 0 {
-			s.headerFooterContextActive = true
-			s.headerClosure()
-			s.headerFooterContextActive = false
-		}
-	}
+	s.startPage()
 
 	s.rowHeight = height
 	s.rowColCount = 0
 
+	if !s.headerFooterContextActive {
+		s.rowIndex = index
+	}
+
+	// Anchors and bookmarks marked before the Row point to its top,
+	// after the page break and the header
+	if !s.headerFooterContextActive {
+		s.resolvePendingMarks()
+	}
+	s.rowContextActive = true
+
 	// This closure has only JustPdf.Cols, which are
 	// not executed firstly, they are added to colsClosures
 	// and this enable us to know how many cols will be added
@@ -309,6 +728,7 @@ func (s *PdfJustPdf) Row(height float64, closure func()) {
 		colClosure()
 	}
 
+	s.rowContextActive = false
 	s.colsClosures = nil
 	s.offsetY += s.rowHeight
 	s.Pdf.Ln(s.rowHeight)
@@ -342,21 +762,69 @@ func (s *PdfJustPdf) ColSpaces(qtd int) {
 }
 
 // Text create a text inside a cell.
+// Cross-references to anchors, {ref:name} for the page and {section:name}
+// for the number of the heading before the anchor, are filled in the texts,
+// ex: "see section {section:pricing} on page {ref:pricing}". They are also filled
+// in the texts of headings, footnotes, lists and tables. When the document is
+// created by Render, they are filled in with the pages and the numbers found by
+// the previous execution, so the texts are measured with them. Otherwise they
+// are filled in when the document is finished, and the rows of footnotes, lists
+// and tables are measured with the cross-references before they are filled in.
 func (s *PdfJustPdf) Text(text string, prop ...props.Text) {
 	textProp := props.Text{}
 	if len(prop) > 0 {
 		textProp = prop[0]
 	}
 
+	text = s.resolvePreviousReferences(text)
+	cell := s.getCellPosition()
+
+	// Without Render, cross-references are only known when the document is finished
+	if referencePattern.MatchString(text) {
+		s.deferText(text, textProp, cell)
+		return
+	}
+
+	s.addText(text, textProp, cell)
+}
+
+// addText write a text inside a cell
+func (s *PdfJustPdf) addText(text string, textProp props.Text, cell cellPosition) {
 	textProp.MakeValid()
 
-	if textProp.Top > s.rowHeight {
-		textProp.Top = s.rowHeight
+	if textProp.Top > cell.height {
+		textProp.Top = cell.height
+	}
+
+	// A rotated text is fitted inside the space of the cell below Top
+	if textProp.Rotation != 0 {
+		s.TextHelper.AddRotated(text, textProp, cell.offsetY+textProp.Top, cell.actualCol, cell.qtdCols, cell.height-textProp.Top)
+
+		if textProp.Link.URL != "" || textProp.Link.Anchor != "" {
+			s.addLink(textProp.Link, cell.actualCol*cell.width, cell.offsetY, cell.width, cell.height)
+		}
+
+		return
+	}
+
+	if textProp.ShrinkToFit {
+		availableHeight := cell.height - textProp.Top
+		textProp.Size = s.TextHelper.GetFitSize(text, textProp, cell.qtdCols, availableHeight)
 	}
 
-	sumOfYOffsets := textProp.Top + s.offsetY
+	if textProp.VerticalAlign == consts.Middle || textProp.VerticalAlign == consts.Bottom {
+		textProp.Top = s.getTextVerticalOffset(text, textProp, cell)
+	}
+
+	sumOfYOffsets := textProp.Top + cell.offsetY
 
-	s.TextHelper.Add(text, textProp, sumOfYOffsets, s.rowColCount, float64(len(s.colsClosures)))
+	s.TextHelper.Add(text, textProp, sumOfYOffsets, cell.actualCol, cell.qtdCols)
+
+	if textProp.Link.URL != "" || textProp.Link.Anchor != "" {
+		fontHeight, textHeight := s.getTextHeight(text, textProp, cell.qtdCols)
+		textX, textWidth := s.getTextHorizontalBox(text, textProp, cell)
+		s.addLink(textProp.Link, cell.actualCol*cell.width+textX, sumOfYOffsets-fontHeight, textWidth, textHeight)
+	}
 }
 
 // FileImage add an Image reading from disk inside a cell.
@@ -372,6 +840,8 @@ func (s *PdfJustPdf) FileImage(filePathName string, prop ...props.Rect) error {
 	qtdCols := float64(len(s.colsClosures))
 	sumOfyOffsets := s.offsetY + rectProp.Top
 
+	s.Link(rectProp.Link)
+
 	return s.Image.AddFromFile(filePathName, sumOfyOffsets, s.rowColCount, qtdCols, s.rowHeight, rectProp)
 }
 
@@ -388,12 +858,19 @@ func (s *PdfJustPdf) Base64Image(base64 string, extension consts.Extension, prop
 	qtdCols := float64(len(s.colsClosures))
 	sumOfyOffsets := s.offsetY + rectProp.Top
 
+	s.Link(rectProp.Link)
+
 	return s.Image.AddFromBase64(base64, sumOfyOffsets, s.rowColCount, qtdCols, s.rowHeight, rectProp, extension)
 }
 
 // OutputFileAndClose save pdf in disk.
 func (s *PdfJustPdf) OutputFileAndClose(filePathName string) (err error) {
+	s.addKeptRows(0)
+	s.Endnotes()
 	s.drawLastFooter()
+	s.resolvePendingMarks()
+	s.drawDeferredTexts()
+	s.drawBookmarks()
 	err = s.Pdf.OutputFileAndClose(filePathName)
 
 	return
@@ -401,7 +878,12 @@ func (s *PdfJustPdf) OutputFileAndClose(filePathName string) (err error) {
 
 // Output extract PDF in byte slices
 func (s *PdfJustPdf) Output() (bytes.Buffer, error) {
+	s.addKeptRows(0)
+	s.Endnotes()
 	s.drawLastFooter()
+	s.resolvePendingMarks()
+	s.drawDeferredTexts()
+	s.drawBookmarks()
 	var buffer bytes.Buffer
 	err := s.Pdf.Output(&buffer)
 	return buffer, err
@@ -436,27 +918,1100 @@ func (s *PdfJustPdf) QrCode(code string, prop ...props.Rect) {
 	qtdCols := float64(len(s.colsClosures))
 	sumOfyOffsets := s.offsetY + rectProp.Top
 	s.Code.AddQr(code, sumOfyOffsets, s.rowColCount, qtdCols, s.rowHeight, rectProp)
+
+	s.Link(rectProp.Link)
 }
 
-func (s *PdfJustPdf) createColSpace(actualWidthPerCol float64) {
-	border := ""
+// Link make the current cell clickable, pointing to an external
+// URL or to an internal anchor marked with Anchor
+func (s *PdfJustPdf) Link(link props.Link) {
+	if link.URL == "" && link.Anchor == "" {
+		return
+	}
 
-	if s.debugMode {
-		border = "1"
+	cell := s.getCellPosition()
+	s.addLink(link, cell.actualCol*cell.width, cell.offsetY, cell.width, cell.height)
+}
+
+// DrawLine draw a line inside the currently row, with x from the
+// left margin and y from the top of the row
+func (s *PdfJustPdf) DrawLine(x1, y1, x2, y2 float64, prop ...props.Line) {
+	lineProp := props.Line{}
+	if len(prop) > 0 {
+		lineProp = prop[0]
 	}
 
-	s.Pdf.CellFormat(actualWidthPerCol, s.rowHeight, "", border, 0.0, "C", !s.backgroundColor.IsWhite(), 0.0, "")
+	lineProp.MakeValid()
+
+	left, top, _, _ := s.Pdf.GetMargins()
+
+	// The color and the width are restored, so the other lines aren't changed
+	red, green, blue := s.Pdf.GetDrawColor()
+	width := s.Pdf.GetLineWidth()
+
+	s.Pdf.SetDrawColor(lineProp.Color.Red, lineProp.Color.Green, lineProp.Color.Blue)
+	s.Pdf.SetLineWidth(lineProp.Width)
+
+	// The square caps join the lines which meet at a corner, as the borders of a cell
+	s.Pdf.SetLineCapStyle("square")
+	s.Pdf.Line(left+x1, s.offsetY+top+y1, left+x2, s.offsetY+top+y2)
+	s.Pdf.SetLineCapStyle("butt")
+
+	s.Pdf.SetDrawColor(red, green, blue)
+	s.Pdf.SetLineWidth(width)
 }
 
-func (s *PdfJustPdf) drawLastFooter() {
-	if s.footerClosure != nil {
-		_, pageHeight := s.Pdf.GetPageSize()
-		_, top, _, bottom := s.Pdf.GetMargins()
+// FillRect fill a rectangle inside the currently row with a color, with
+// x from the left margin and y from the top of the row
+func (s *PdfJustPdf) FillRect(x, y, width, height float64, color color.Color) {
+	left, top, _, _ := s.Pdf.GetMargins()
 
-		if s.offsetY+s.footerHeight < pageHeight-bottom-top {
-			s.headerFooterContextActive = true
-			s.footerClosure()
-			s.headerFooterContextActive = false
+	s.Pdf.SetFillColor(color.Red, color.Green, color.Blue)
+	s.Pdf.Rect(left+x, s.offsetY+top+y, width, height, "F")
+
+	// The background of the next cells is kept
+	s.Pdf.SetFillColor(s.backgroundColor.Red, s.backgroundColor.Green, s.backgroundColor.Blue)
+}
+
+// Anchor mark the current position as the destination of links and cross-references with
+// the same name. Inside a Row it is the top of the row, outside it is the top of the next Row
+func (s *PdfJustPdf) Anchor(name string) {
+	number := s.getHeadingNumber()
+
+	s.mark(func() {
+		s.setAnchor(name)
+		s.setReference(name, number)
+	})
+}
+
+// Bookmark add an entry to the outline showed in the sidebar of PDF viewers, pointing to the
+// current position like Anchor. Level 0 is the root, each level is nested inside the previous.
+// The title is written with any characters, it doesn't depend on the font
+func (s *PdfJustPdf) Bookmark(title string, level int) {
+	if level < 0 {
+		level = 0
+	}
+
+	// A level can't skip its parent
+	maxLevel := 0
+	if len(s.bookmarks) > 0 {
+		maxLevel = s.bookmarks[len(s.bookmarks)-1].level + 1
+	}
+
+	if level > maxLevel {
+		level = maxLevel
+	}
+
+	// The outline and the TableOfContents list the title without its superscript parts
+	index := len(s.bookmarks)
+	s.bookmarks = append(s.bookmarks, bookmark{title: internal.RemoveRunMarks(title), level: level})
+
+	s.mark(func() {
+		s.bookmarks[index].page = s.pageIndex + 1
+		s.bookmarks[index].offsetY = s.offsetY
+
+		if s.previousLayout != nil {
+			s.setAnchor(fmt.Sprintf("%s%d", bookmarkAnchorPrefix, index))
+		}
+	})
+}
+
+// IndexTerm record the current page to a term of the Index, pointing to the current position
+// like Anchor. The subterm is optional, ex: IndexTerm("Table", "borders") is listed below "Table"
+func (s *PdfJustPdf) IndexTerm(term, subterm string) {
+	term = strings.TrimSpace(internal.RemoveRunMarks(term))
+	if term == "" {
+		return
+	}
+
+	index := len(s.indexTerms)
+	s.indexTerms = append(s.indexTerms, indexTerm{term: term, subterm: strings.TrimSpace(internal.RemoveRunMarks(subterm))})
+
+	s.mark(func() {
+		s.indexTerms[index].page = s.pageIndex + 1
+	})
+}
+
+// SetHeadingStyle define the style of the headings from a level, which is used when
+// Heading is called without properties
+func (s *PdfJustPdf) SetHeadingStyle(level int, prop props.Heading) {
+	if s.headingStyles == nil {
+		s.headingStyles = make(map[int]props.Heading)
+	}
+
+	prop.MakeValid()
+	s.headingStyles[level] = prop
+}
+
+// Footnote add a note to the bottom of the page, above the footer, and return
+// the superscript mark which references it, to be written with the text, ex:
+// m.Text("Revenue" + m.Footnote("Audited figures")). The body height of the page
+// is reduced by the height of its footnotes, a footnote which doesn't fit in the
+// page is moved to the next one. When the document is created by Render, the Row which
+// references it is moved with the note, otherwise the Row stays in its page, apart from
+// the note. With props.Footnote Endnote, the note is collected to the endnotes instead.
+func (s *PdfJustPdf) Footnote(text string, prop ...props.Footnote) string {
+	footnoteProp := props.Footnote{}
+	if len(prop) > 0 {
+		footnoteProp = prop[0]
+	}
+
+	footnoteProp.MakeValid()
+
+	if footnoteProp.Endnote {
+		s.endnoteCount++
+		mark := strconv.Itoa(s.endnoteCount)
+		s.endnotes = append(s.endnotes, footnote{mark: mark, text: text, prop: footnoteProp})
+		return internal.Superscript(mark)
+	}
+
+	s.footnoteCount++
+	note := footnote{mark: strconv.Itoa(s.footnoteCount), text: text, prop: footnoteProp}
+	note.height = s.getFootnoteHeight(note)
+
+	_, pageHeight := s.Pdf.GetPageSize()
+	_, top, _, bottom := s.Pdf.GetMargins()
+
+	// The Row which references the footnote is already in the page
+	offsetY := s.offsetY
+	if s.rowContextActive {
+		offsetY += s.rowHeight
+	}
+
+	notesHeight := s.getFootnotesHeight(append(s.footnotes, note))
+
+	if len(s.nextFootnotes) == 0 && offsetY+s.footerHeight+notesHeight <= pageHeight-bottom-top {
+		s.footnotes = append(s.footnotes, note)
+	} else {
+		s.nextFootnotes = append(s.nextFootnotes, note)
+
+		// The next pass starts a new page with the Row, unless it is already the first one
+		if s.rowContextActive && !s.headerFooterContextActive && s.offsetY > 0 {
+			s.addMovedRow(s.rowIndex)
+		}
+	}
+
+	return internal.Superscript(note.mark)
+}
+
+// Endnotes add a Row to each endnote collected until now, ex: at the end of a section.
+// The endnotes which remain are added at the end of the document.
+func (s *PdfJustPdf) Endnotes() {
+	endnotes := s.endnotes
+	s.endnotes = nil
+
+	for _, note := range endnotes {
+		note.height = s.getFootnoteHeight(note)
+		s.addFootnoteRow(note)
+	}
+}
+
+// Heading add a Row with a numbered title, ex: "2.3.1 Title", and a bookmark to it.
+// Level 0 is the top level, like in Bookmark. The heading is kept in the same page
+// of the next Row, so a heading is never the last Row of a page
+func (s *PdfJustPdf) Heading(level int, text string, prop ...props.Heading) {
+	// A level can't skip its parent
+	if level > len(s.headingNumbers) {
+		level = len(s.headingNumbers)
+	}
+
+	if level < 0 {
+		level = 0
+	}
+
+	headingProp := s.getHeadingStyle(level)
+	if len(prop) > 0 {
+		headingProp = prop[0]
+		headingProp.MakeValid()
+	}
+
+	if level < len(s.headingNumbers) {
+		s.headingNumbers = s.headingNumbers[:level+1]
+	} else {
+		s.headingNumbers = append(s.headingNumbers, 0)
+	}
+
+	s.headingNumbers[level]++
+
+	title := text
+	if !headingProp.Unnumbered {
+		title = s.getHeadingNumber() + " " + text
+	}
+
+	textProp := headingProp.Font.ToTextProp(consts.Left, 0.0, false, 0.0)
+	textProp.Color = headingProp.Color
+	textProp.VerticalAlign = consts.Bottom
+
+	s.KeepWithNext(headingProp.RowHeight, func() {
+		s.Bookmark(title, level)
+		s.Row(headingProp.RowHeight, func() {
+			s.Col(func() {
+				s.Text(title, textProp)
+			})
+		})
+	})
+}
+
+// TableOfContents add a Row for each bookmark, with its title, dot leaders and page number,
+// linked to the bookmark position. When the document is created by Render the bookmarks
+// of the whole document are listed, otherwise only the bookmarks marked until now
+func (s *PdfJustPdf) TableOfContents(prop ...props.TableOfContents) {
+	tocProp := props.TableOfContents{}
+	if len(prop) > 0 {
+		tocProp = prop[0]
+	}
+
+	tocProp.MakeValid()
+
+	bookmarks := s.bookmarks
+	if s.previousLayout != nil {
+		bookmarks = s.previousLayout.bookmarks
+	} else {
+		s.resolvePendingMarks()
+	}
+
+	for index, entry := range bookmarks {
+		if tocProp.Levels > 0 && entry.level >= tocProp.Levels {
+			continue
+		}
+
+		anchor := fmt.Sprintf("%s%d", bookmarkAnchorPrefix, index)
+		indent := float64(entry.level) * tocProp.Indent
+		title := s.resolvePreviousReferences(entry.title)
+		page := strconv.Itoa(entry.page)
+
+		// Without Render the bookmarks don't set its anchors, the position is already known
+		if s.previousLayout == nil {
+			_, top, _, _ := s.Pdf.GetMargins()
+			s.Pdf.SetLink(s.getAnchorLink(anchor), entry.offsetY+top, entry.page)
 		}
+
+		s.Row(tocProp.RowHeight, func() {
+			s.Col(func() {
+				s.addTableOfContentsEntry(title, page, anchor, indent, tocProp)
+			})
+		})
 	}
 }
+
+// addTableOfContentsEntry write the title after the indent, filling the space until the page number with the leader
+func (s *PdfJustPdf) addTableOfContentsEntry(title, page, anchor string, indent float64, tocProp props.TableOfContents) {
+	textProp := tocProp.Font.ToTextProp(consts.Left, 0.0, true, 0.0)
+	textProp.VerticalAlign = consts.Middle
+	textProp.Link = props.Link{Anchor: anchor}
+
+	width, _ := s.Pdf.GetPageSize()
+	left, _, right, _ := s.Pdf.GetMargins()
+
+	s.inCell(indent, width-left-right-indent, func() {
+		widthPerCol := s.Math.GetWidthPerCol(float64(len(s.colsClosures)))
+		titleWidth := s.TextHelper.GetStringWidth(title+" ", textProp)
+		pageWidth := s.TextHelper.GetStringWidth(" "+page, textProp)
+		leaderWidth := s.TextHelper.GetStringWidth(tocProp.Leader, textProp)
+
+		if leaderWidth > 0 && widthPerCol > titleWidth+pageWidth {
+			title += " " + strings.Repeat(tocProp.Leader, int((widthPerCol-titleWidth-pageWidth)/leaderWidth))
+		}
+
+		s.Text(title, textProp)
+	})
+
+	textProp.Align = consts.Right
+	textProp.Link = props.Link{}
+	s.Text(page, textProp)
+}
+
+// Index add a two-column alphabetical index of the terms marked with IndexTerm, grouped by
+// its first letter, with the subterms below its term and consecutive pages written as a
+// range, ex: "Table, 3–5, 9". The columns are filled one after the other in each page.
+// When the document is created by Render the terms of the whole document are listed,
+// otherwise only the terms marked until now
+func (s *PdfJustPdf) Index(prop ...props.Index) {
+	indexProp := props.Index{}
+	if len(prop) > 0 {
+		indexProp = prop[0]
+	}
+
+	indexProp.MakeValid()
+
+	// A heading before the Index is kept with its first Row
+	s.addKeptRows(indexProp.RowHeight)
+
+	terms := s.indexTerms
+	if s.previousLayout != nil {
+		terms = s.previousLayout.indexTerms
+	} else {
+		s.resolvePendingMarks()
+	}
+
+	lines := s.getIndexLines(terms)
+	pageBroken := false
+
+	for len(lines) > 0 {
+		// The header of a new page is added first, so the space left is known
+		s.startPage()
+
+		rows := s.getAvailableRows(indexProp.RowHeight)
+		if rows < 1 && !pageBroken {
+			s.breakPage()
+			pageBroken = true
+			continue
+		}
+
+		// A Row higher than the page is added anyway
+		if rows < 1 {
+			rows = 1
+		}
+
+		pageBroken = false
+
+		left, right := s.splitIndexColumns(lines, rows)
+		s.addIndexRows(left, right, indexProp)
+		lines = lines[len(left)+len(right):]
+	}
+}
+
+// getIndexLines sort the terms alphabetically, joining the pages of the same term,
+// and return the lines of the Index with the letter before each group of terms
+func (s *PdfJustPdf) getIndexLines(terms []indexTerm) []indexLine {
+	pages := make(map[string]map[string][]int)
+	for _, entry := range terms {
+		if pages[entry.term] == nil {
+			pages[entry.term] = make(map[string][]int)
+		}
+
+		pages[entry.term][entry.subterm] = append(pages[entry.term][entry.subterm], entry.page)
+	}
+
+	var names []string
+	for term := range pages {
+		names = append(names, term)
+	}
+
+	s.sortIndexTerms(names)
+
+	var lines []indexLine
+	letter := ""
+
+	for _, term := range names {
+		if s.getIndexLetter(term) != letter {
+			letter = s.getIndexLetter(term)
+			lines = append(lines, indexLine{text: letter, letter: true})
+		}
+
+		lines = append(lines, indexLine{text: s.getIndexEntry(term, pages[term][""])})
+
+		var subterms []string
+		for subterm := range pages[term] {
+			if subterm != "" {
+				subterms = append(subterms, subterm)
+			}
+		}
+
+		s.sortIndexTerms(subterms)
+
+		for _, subterm := range subterms {
+			lines = append(lines, indexLine{text: s.getIndexEntry(subterm, pages[term][subterm]), indent: true})
+		}
+	}
+
+	return lines
+}
+
+// sortIndexTerms sort the terms by its letter, then ignoring the case
+func (s *PdfJustPdf) sortIndexTerms(terms []string) {
+	sort.Slice(terms, func(i, j int) bool {
+		letterI, letterJ := s.getIndexLetter(terms[i]), s.getIndexLetter(terms[j])
+		if letterI != letterJ {
+			return letterI < letterJ
+		}
+
+		lowerI, lowerJ := strings.ToLower(terms[i]), strings.ToLower(terms[j])
+		if lowerI != lowerJ {
+			return lowerI < lowerJ
+		}
+
+		return terms[i] < terms[j]
+	})
+}
+
+// getIndexLetter return the upper case first letter of a term, terms
+// which doesn't start with a letter are grouped as "#"
+func (s *PdfJustPdf) getIndexLetter(term string) string {
+	first := []rune(term)[0]
+	if !unicode.IsLetter(first) {
+		return "#"
+	}
+
+	return string(unicode.ToUpper(first))
+}
+
+// getIndexEntry return a term followed by its pages, with consecutive pages as a range, ex: "Table, 3–5, 9"
+func (s *PdfJustPdf) getIndexEntry(term string, pages []int) string {
+	if len(pages) == 0 {
+		return term
+	}
+
+	sort.Ints(pages)
+
+	var ranges []string
+	for first := 0; first < len(pages); {
+		last := first
+		for last+1 < len(pages) && pages[last+1] <= pages[last]+1 {
+			last++
+		}
+
+		if pages[first] == pages[last] {
+			ranges = append(ranges, strconv.Itoa(pages[first]))
+		} else {
+			ranges = append(ranges, strconv.Itoa(pages[first])+pageRangeSeparator+strconv.Itoa(pages[last]))
+		}
+
+		first = last + 1
+	}
+
+	return term + ", " + strings.Join(ranges, ", ")
+}
+
+// getAvailableRows return how many Rows with the height fit in the space left in the page
+func (s *PdfJustPdf) getAvailableRows(height float64) int {
+	_, pageHeight := s.Pdf.GetPageSize()
+	_, top, _, bottom := s.Pdf.GetMargins()
+
+	space := pageHeight - bottom - top - s.offsetY - s.footerHeight - s.getFootnotesHeight(s.footnotes)
+
+	return int(space / height)
+}
+
+// splitIndexColumns return the lines of the left and of the right column of a page with space
+// to the rows, the columns are balanced when the Index ends in the page. A letter is never the
+// last line of a column, it is moved to the top of the next one
+func (s *PdfJustPdf) splitIndexColumns(lines []indexLine, rows int) (left []indexLine, right []indexLine) {
+	size := rows
+	if len(lines) < 2*rows {
+		size = (len(lines) + 1) / 2
+	}
+
+	left = lines[:size]
+	if size > 1 && left[size-1].letter {
+		left = left[:size-1]
+	}
+
+	remaining := lines[len(left):]
+	if len(remaining) > rows {
+		right = remaining[:rows]
+		if rows > 1 && right[rows-1].letter {
+			right = right[:rows-1]
+		}
+	} else {
+		right = remaining
+	}
+
+	return left, right
+}
+
+// addIndexRows add a Row to each line of the columns, which are written side by side
+func (s *PdfJustPdf) addIndexRows(left, right []indexLine, indexProp props.Index) {
+	width, _ := s.Pdf.GetPageSize()
+	marginLeft, _, marginRight, _ := s.Pdf.GetMargins()
+	columnWidth := (width - marginLeft - marginRight - indexProp.ColumnGap) / 2.0
+
+	for row := 0; row < len(left) || row < len(right); row++ {
+		s.Row(indexProp.RowHeight, func() {
+			s.Col(func() {
+				if row < len(left) {
+					s.addIndexLine(left[row], 0.0, columnWidth, indexProp)
+				}
+
+				if row < len(right) {
+					s.addIndexLine(right[row], columnWidth+indexProp.ColumnGap, columnWidth, indexProp)
+				}
+			})
+		})
+	}
+}
+
+// addIndexLine write a line of the Index vertically centered in its column, which starts at x
+// from the left margin. A line longer than the column is cut with an ellipsis
+func (s *PdfJustPdf) addIndexLine(line indexLine, x, columnWidth float64, indexProp props.Index) {
+	font := indexProp.Font
+	if line.letter {
+		font = indexProp.LetterFont
+	}
+
+	textProp := font.ToTextProp(consts.Left, 0.0, true, 0.0)
+	textProp.Color = indexProp.Color
+	textProp.Ellipsis = true
+
+	indent := 0.0
+	if line.indent {
+		indent = indexProp.Indent
+	}
+
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := textProp.Size / s.Font.GetScaleFactor()
+	baseline := s.offsetY + s.Math.GetAlignCorrection(consts.Middle, s.rowHeight, fontHeight) + fontHeight
+
+	s.inCell(x+indent, columnWidth-indent, func() {
+		s.TextHelper.Add(line.text, textProp, baseline, 0, 1)
+	})
+}
+
+// getHeadingStyle return the style registered to a level or the default one
+func (s *PdfJustPdf) getHeadingStyle(level int) props.Heading {
+	if style, ok := s.headingStyles[level]; ok {
+		return style
+	}
+
+	size := defaultHeadingSizes[len(defaultHeadingSizes)-1]
+	if level >= 0 && level < len(defaultHeadingSizes) {
+		size = defaultHeadingSizes[level]
+	}
+
+	style := props.Heading{
+		Font: props.Font{
+			Size: size,
+		},
+	}
+	style.MakeValid()
+
+	return style
+}
+
+// addKeptRows add the kept Rows in the page which has space to them and the next Row
+func (s *PdfJustPdf) addKeptRows(nextHeight float64) {
+	if len(s.keptRows) == 0 {
+		return
+	}
+
+	keptRows := s.keptRows
+	s.keptRows = nil
+
+	height := nextHeight
+	for _, row := range keptRows {
+		height += row.height
+	}
+
+	s.ensureSpace(height)
+
+	for _, row := range keptRows {
+		row.closure()
+	}
+}
+
+// ensureSpace move to the next page when the height isn't available in the current page
+func (s *PdfJustPdf) ensureSpace(height float64) {
+	if s.offsetY == 0 {
+		return
+	}
+
+	_, pageHeight := s.Pdf.GetPageSize()
+	_, top, _, bottom := s.Pdf.GetMargins()
+
+	totalOffsetY := int(s.offsetY + height + s.footerHeight + s.getFootnotesHeight(s.footnotes))
+	maxOffsetPage := int(pageHeight - bottom - top)
+
+	if totalOffsetY > maxOffsetPage {
+		s.breakPage()
+	}
+}
+
+// startPage add the header and the repeated header when nothing was added to the current page
+func (s *PdfJustPdf) startPage() {
+	if s.headerFooterContextActive || s.offsetY != 0 {
+		return
+	}
+
+	if s.headerClosure != nil {
+		s.headerFooterContextActive = true
+		s.headerClosure()
+		s.headerFooterContextActive = false
+	}
+
+	// The repeated header is added after the header
+	if s.repeatedHeaderClosure != nil {
+		s.headerFooterContextActive = true
+		s.repeatedHeaderClosure()
+		s.headerFooterContextActive = false
+	}
+}
+
+// breakPage add the footer and start a new page, the header
+// is added by the next Row
+func (s *PdfJustPdf) breakPage() {
+	s.drawFootnotes()
+	s.drawRepeatedFooter()
+	if s.footerClosure != nil {
+		s.headerFooterContextActive = true
+		s.footerClosure()
+		s.headerFooterContextActive = false
+	}
+
+	s.Pdf.AddPage()
+	s.offsetY = 0
+	s.pageIndex++
+	s.moveFootnotesToPage()
+}
+
+// drawRepeatedFooter add the repeated footer at the end of a page, after the footnotes
+func (s *PdfJustPdf) drawRepeatedFooter() {
+	if s.repeatedFooterClosure == nil {
+		return
+	}
+
+	s.headerFooterContextActive = true
+	s.repeatedFooterClosure()
+	s.headerFooterContextActive = false
+}
+
+// getLayout return what is known about the document after the pagination
+func (s *PdfJustPdf) getLayout() *layout {
+	bookmarks := make([]bookmark, len(s.bookmarks))
+	copy(bookmarks, s.bookmarks)
+
+	indexTerms := make([]indexTerm, len(s.indexTerms))
+	copy(indexTerms, s.indexTerms)
+
+	movedRows := make([]int, len(s.movedRows))
+	copy(movedRows, s.movedRows)
+
+	references := make(map[string]reference, len(s.references))
+	for name, reference := range s.references {
+		references[name] = reference
+	}
+
+	return &layout{
+		bookmarks:  bookmarks,
+		indexTerms: indexTerms,
+		movedRows:  movedRows,
+		references: references,
+	}
+}
+
+// isMovedRow return if a Row was moved to the next page by the previous pass
+func (s *PdfJustPdf) isMovedRow(index int) bool {
+	if s.previousLayout == nil {
+		return false
+	}
+
+	for _, moved := range s.previousLayout.movedRows {
+		if moved == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addMovedRow record a Row which starts a new page, so the next pass keeps it there
+func (s *PdfJustPdf) addMovedRow(index int) {
+	if len(s.movedRows) > 0 && s.movedRows[len(s.movedRows)-1] == index {
+		return
+	}
+
+	s.movedRows = append(s.movedRows, index)
+}
+
+// getTextVerticalOffset return the distance between the top of the row and
+// the baseline of the first line to align a text vertically inside the row,
+// Top is a padding and the text is aligned in the space below it
+func (s *PdfJustPdf) getTextVerticalOffset(text string, textProp props.Text, cell cellPosition) float64 {
+	fontHeight, textHeight := s.getTextHeight(text, textProp, cell.qtdCols)
+	correction := s.Math.GetAlignCorrection(textProp.VerticalAlign, cell.height-textProp.Top, textHeight)
+
+	return textProp.Top + correction + fontHeight
+}
+
+// getTextHeight return the height of one line and the height of all lines occupied by a text
+func (s *PdfJustPdf) getTextHeight(text string, textProp props.Text, qtdCols float64) (fontHeight float64, textHeight float64) {
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight = textProp.Size / s.Font.GetScaleFactor()
+	textHeight = s.TextHelper.GetLinesHeight(text, textProp, qtdCols)
+
+	return fontHeight, textHeight
+}
+
+// getTextHorizontalBox return the start, from the left of the cell, and the width of the lines
+// of a text. Wrapped lines and lines with tab stops take the width of the cell
+func (s *PdfJustPdf) getTextHorizontalBox(text string, textProp props.Text, cell cellPosition) (float64, float64) {
+	if len(textProp.TabStops) > 0 || s.TextHelper.GetLinesQuantity(text, textProp, cell.qtdCols) > 1 {
+		return 0, cell.width
+	}
+
+	width := s.TextHelper.GetStringWidth(text, textProp)
+	if textProp.Ellipsis && width > cell.width {
+		width = cell.width
+	}
+
+	// Without Align, the text is aligned by the direction found in it
+	align := textProp.Align
+	if align == "" {
+		align = consts.Left
+		if internal.NewBidi().GetDirection(text, textProp.Direction) == consts.RightToLeft {
+			align = consts.Right
+		}
+	}
+
+	switch align {
+	case consts.Left:
+		return 0, width
+	case consts.Right:
+		return cell.width - width, width
+	default:
+		return (cell.width - width) / 2, width
+	}
+}
+
+// addLink make an area clickable, with x from the left margin and y from the top margin
+func (s *PdfJustPdf) addLink(link props.Link, x, y, width, height float64) {
+	left, top, _, _ := s.Pdf.GetMargins()
+
+	if link.URL != "" {
+		s.Pdf.LinkString(x+left, y+top, width, height, link.URL)
+		return
+	}
+
+	s.Pdf.Link(x+left, y+top, width, height, s.getAnchorLink(link.Anchor))
+}
+
+// getCellPosition return the place of the current column
+func (s *PdfJustPdf) getCellPosition() cellPosition {
+	qtdCols := float64(len(s.colsClosures))
+
+	return cellPosition{
+		offsetY:   s.offsetY,
+		height:    s.rowHeight,
+		actualCol: s.rowColCount,
+		qtdCols:   qtdCols,
+		width:     s.Math.GetWidthPerCol(qtdCols),
+	}
+}
+
+// mark execute a closure which records the current position, outside
+// a Row it is delayed until the top of the next Row is known
+func (s *PdfJustPdf) mark(closure func()) {
+	if s.rowContextActive {
+		closure()
+		return
+	}
+
+	// Kept Rows are added later, the next Row is the one after them
+	if len(s.keptRows) > 0 {
+		last := len(s.keptRows) - 1
+		keptClosure := s.keptRows[last].closure
+
+		s.keptRows[last].closure = func() {
+			keptClosure()
+			s.pendingMarks = append(s.pendingMarks, closure)
+		}
+
+		return
+	}
+
+	s.pendingMarks = append(s.pendingMarks, closure)
+}
+
+// resolvePendingMarks execute the closures delayed by mark, at the end
+// of the document they point to the end of the last page
+func (s *PdfJustPdf) resolvePendingMarks() {
+	for _, mark := range s.pendingMarks {
+		mark()
+	}
+
+	s.pendingMarks = nil
+}
+
+// drawBookmarks add the bookmarks to its pages, gofpdf only adds bookmarks to the
+// current page, so this is done when the document is finished
+func (s *PdfJustPdf) drawBookmarks() {
+	if len(s.bookmarks) == 0 {
+		return
+	}
+
+	_, top, _, _ := s.Pdf.GetMargins()
+	translator := s.Pdf.UnicodeTranslatorFromDescriptor("")
+
+	// gofpdf converts the titles to UTF-16 only when the current font is an UTF-8 one
+	utf8 := s.Font.IsUTF8(s.Font.GetFamily())
+
+	for _, bookmark := range s.bookmarks {
+		s.Pdf.SetPage(bookmark.page)
+		title := s.resolveReferences(bookmark.title)
+		s.Pdf.Bookmark(s.getBookmarkTitle(title, utf8, translator), bookmark.level, bookmark.offsetY+top)
+	}
+
+	s.Pdf.SetPage(s.Pdf.PageCount())
+	s.bookmarks = nil
+}
+
+// getBookmarkTitle return the title of a bookmark encoded to gofpdf, which converts it when the
+// current font is an UTF-8 one. Otherwise a title with only Latin-1 characters is translated
+// and the others are written as UTF-16, so they don't depend on the font
+func (s *PdfJustPdf) getBookmarkTitle(title string, utf8 bool, translator func(string) string) string {
+	if utf8 {
+		return title
+	}
+
+	for _, char := range title {
+		if char > unicode.MaxLatin1 {
+			return toUTF16(title)
+		}
+	}
+
+	return translator(title)
+}
+
+// setAnchor define the destination of an anchor as the current offset
+func (s *PdfJustPdf) setAnchor(name string) {
+	_, top, _, _ := s.Pdf.GetMargins()
+	s.Pdf.SetLink(s.getAnchorLink(name), s.offsetY+top, s.pageIndex+1)
+}
+
+// setReference record the page and the heading number of an anchor, to the cross-references
+func (s *PdfJustPdf) setReference(name, number string) {
+	if s.references == nil {
+		s.references = make(map[string]reference)
+	}
+
+	s.references[name] = reference{page: s.pageIndex + 1, number: number}
+}
+
+// getHeadingNumber return the number of the last heading, ex: 2.3.1
+func (s *PdfJustPdf) getHeadingNumber() string {
+	numbers := make([]string, len(s.headingNumbers))
+	for index, number := range s.headingNumbers {
+		numbers[index] = strconv.Itoa(number)
+	}
+
+	return strings.Join(numbers, ".")
+}
+
+// resolveReferences replace the cross-references of a text by the page
+// or by the heading number of its anchors
+func (s *PdfJustPdf) resolveReferences(text string) string {
+	return replaceReferences(text, s.references)
+}
+
+// resolvePreviousReferences replace the cross-references of a text by the ones found by the
+// previous pass of Render, without it the text is returned as it is
+func (s *PdfJustPdf) resolvePreviousReferences(text string) string {
+	if s.previousLayout == nil || !referencePattern.MatchString(text) {
+		return text
+	}
+
+	return replaceReferences(text, s.previousLayout.references)
+}
+
+// replaceReferences replace the cross-references of a text by the page
+// or by the heading number of the given anchors
+func replaceReferences(text string, references map[string]reference) string {
+	return referencePattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := referencePattern.FindStringSubmatch(match)
+
+		reference, ok := references[groups[2]]
+		if !ok {
+			return unknownReference
+		}
+
+		if groups[1] == "section" {
+			return reference.number
+		}
+
+		return strconv.Itoa(reference.page)
+	})
+}
+
+// deferText record a Text with cross-references, the position and the margins of its cell
+func (s *PdfJustPdf) deferText(text string, textProp props.Text, cell cellPosition) {
+	left, _, right, _ := s.Pdf.GetMargins()
+
+	s.deferredTexts = append(s.deferredTexts, deferredText{
+		text:  text,
+		prop:  textProp,
+		page:  s.pageIndex + 1,
+		cell:  cell,
+		left:  left,
+		right: right,
+	})
+}
+
+// drawDeferredTexts write the texts with cross-references in its pages, with the margins
+// of its cells, measured after the references are resolved, when all anchors are known
+func (s *PdfJustPdf) drawDeferredTexts() {
+	if len(s.deferredTexts) == 0 && len(s.deferredCellTexts) == 0 {
+		return
+	}
+
+	left, _, right, _ := s.Pdf.GetMargins()
+
+	for _, deferred := range s.deferredCellTexts {
+		s.Pdf.SetPage(deferred.page)
+		s.SetLRMargins(deferred.left, deferred.right)
+		s.TextHelper.Add(s.resolveReferences(deferred.text), deferred.prop, deferred.marginTop, deferred.actualCol, deferred.qtdCols)
+	}
+
+	for _, deferred := range s.deferredTexts {
+		s.Pdf.SetPage(deferred.page)
+		s.SetLRMargins(deferred.left, deferred.right)
+		s.addText(s.resolveReferences(deferred.text), deferred.prop, deferred.cell)
+	}
+
+	s.SetLRMargins(left, right)
+	s.Pdf.SetPage(s.Pdf.PageCount())
+	s.deferredTexts = nil
+	s.deferredCellTexts = nil
+}
+
+// getAnchorLink return the gofpdf link of an anchor, links are created
+// on the first use, so they can be used before the anchor is marked
+func (s *PdfJustPdf) getAnchorLink(name string) int {
+	if s.anchors == nil {
+		s.anchors = make(map[string]int)
+	}
+
+	link, ok := s.anchors[name]
+	if !ok {
+		link = s.Pdf.AddLink()
+		s.anchors[name] = link
+	}
+
+	return link
+}
+
+// inCell execute a closure with the margins moved to a part of the page, which starts at x
+// from the left margin, so the texts and links added by the closure fill only the part
+func (s *PdfJustPdf) inCell(x, width float64, closure func()) {
+	pageWidth, _ := s.Pdf.GetPageSize()
+	left, _, right, _ := s.Pdf.GetMargins()
+
+	s.SetLRMargins(left+x, pageWidth-left-x-width)
+	closure()
+	s.SetLRMargins(left, right)
+}
+
+func (s *PdfJustPdf) createColSpace(actualWidthPerCol float64) {
+	border := ""
+
+	if s.debugMode {
+		border = "1"
+	}
+
+	s.Pdf.CellFormat(actualWidthPerCol, s.rowHeight, "", border, 0.0, "C", !s.backgroundColor.IsWhite(), 0.0, "")
+}
+
+func (s *PdfJustPdf) drawLastFooter() {
+	// Footnotes which didn't fit in the last page need one more page
+	if len(s.nextFootnotes) > 0 {
+		s.breakPage()
+	}
+
+	hasFootnotes := len(s.footnotes) > 0
+	s.drawFootnotes()
+
+	if s.footerClosure != nil {
+		_, pageHeight := s.Pdf.GetPageSize()
+		_, top, _, bottom := s.Pdf.GetMargins()
+
+		if hasFootnotes || s.offsetY+s.footerHeight < pageHeight-bottom-top {
+			s.headerFooterContextActive = true
+			s.footerClosure()
+			s.headerFooterContextActive = false
+		}
+	}
+}
+
+// getFootnoteHeight return the height of the Row of a footnote
+func (s *PdfJustPdf) getFootnoteHeight(note footnote) float64 {
+	textProp := s.getFootnoteTextProp(note)
+	text := s.resolvePreviousReferences(s.getFootnoteText(note))
+	qtdLines := float64(s.TextHelper.GetLinesQuantity(text, textProp, 1))
+
+	return qtdLines*textProp.Top + footnoteSpacing
+}
+
+// getFootnotesHeight return the height of the footnotes area, with the separator line
+func (s *PdfJustPdf) getFootnotesHeight(notes []footnote) float64 {
+	if len(notes) == 0 {
+		return 0
+	}
+
+	height := footnoteSeparatorHeight
+	for _, note := range notes {
+		height += note.height
+	}
+
+	return height
+}
+
+// getFootnoteText return the text of a footnote row, which starts with the superscript mark
+func (s *PdfJustPdf) getFootnoteText(note footnote) string {
+	return internal.Superscript(note.mark) + " " + note.text
+}
+
+// getFootnoteTextProp return the text properties of a footnote, with the baseline of the first line as Top
+func (s *PdfJustPdf) getFootnoteTextProp(note footnote) props.Text {
+	// Font size corrected by the scale factor from "mm" inside gofpdf f.k
+	fontHeight := note.prop.Font.Size / s.Font.GetScaleFactor()
+
+	textProp := note.prop.Font.ToTextProp(consts.Left, fontHeight, false, 0.0)
+	textProp.Color = note.prop.Color
+
+	return textProp
+}
+
+// addFootnoteRow add a Row with a footnote, written in the full width
+func (s *PdfJustPdf) addFootnoteRow(note footnote) {
+	s.Row(note.height, func() {
+		s.Col(func() {
+			s.Text(s.getFootnoteText(note), s.getFootnoteTextProp(note))
+		})
+	})
+}
+
+// drawFootnotes write the footnotes of the page at its bottom, above the footer,
+// starting with a separator line
+func (s *PdfJustPdf) drawFootnotes() {
+	if len(s.footnotes) == 0 {
+		return
+	}
+
+	footnotes := s.footnotes
+	s.footnotes = nil
+
+	width, pageHeight := s.Pdf.GetPageSize()
+	left, top, right, bottom := s.Pdf.GetMargins()
+
+	offsetY := pageHeight - bottom - top - s.footerHeight - s.getFootnotesHeight(footnotes)
+	if offsetY > s.offsetY {
+		s.offsetY = offsetY
+		s.Pdf.SetY(s.offsetY + top)
+	}
+
+	separatorY := s.offsetY + top + footnoteSeparatorHeight/2.0
+	s.Pdf.Line(left, separatorY, left+(width-left-right)/3.0, separatorY)
+	s.offsetY += footnoteSeparatorHeight
+	s.Pdf.Ln(footnoteSeparatorHeight)
+
+	s.headerFooterContextActive = true
+	for _, note := range footnotes {
+		s.addFootnoteRow(note)
+	}
+	s.headerFooterContextActive = false
+}
+
+// moveFootnotesToPage make the footnotes which didn't fit in the previous page part of the new one
+func (s *PdfJustPdf) moveFootnotesToPage() {
+	s.footnotes = s.nextFootnotes
+	s.nextFootnotes = nil
+}
+
+// toUTF16 encode a text as UTF-16 big-endian with the byte order mark, as the PDF text strings
+func toUTF16(text string) string {
+	encoded := []byte{0xfe, 0xff}
+
+	for _, unit := range utf16.Encode([]rune(text)) {
+		encoded = append(encoded, byte(unit>>8), byte(unit))
+	}
+
+	return string(encoded)
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/muhammadmuhlas/just_pdf/pkg/color"
+	"strings"
 	"testing"
 
+	"github.com/muhammadmuhlas/just_pdf/internal"
 	"github.com/muhammadmuhlas/just_pdf/internal/mocks"
 	"github.com/muhammadmuhlas/just_pdf/pkg/consts"
 	"github.com/muhammadmuhlas/just_pdf/pkg/pdf"
@@ -154,6 +156,61 @@ func TestNewPdf(t *testing.T) {
 
 }
 
+func TestRender(t *testing.T) {
+	// Arrange
+	passes := 0
+
+	// Act
+	m := pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+		passes++
+
+		m.TableOfContents()
+
+		for chapter := 0; chapter < 3; chapter++ {
+			m.Bookmark(fmt.Sprintf("Chapter %d", chapter), 0)
+			m.Row(200, func() {})
+		}
+	})
+
+	// Assert
+	assert.Equal(t, passes, 3)
+	assert.Equal(t, m.GetCurrentPage(), 2)
+}
+
+func TestPdfJustPdf_TableOfContents_WhenNotRendered(t *testing.T) {
+	// Arrange
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	m.Bookmark("Chapter", 0)
+	m.Row(20, func() {})
+	m.Bookmark("Section", 1)
+	m.Row(20, func() {})
+
+	offset := m.GetCurrentOffset()
+
+	// Act
+	m.TableOfContents(props.TableOfContents{RowHeight: 7.0})
+
+	// Assert
+	assert.Equal(t, m.GetCurrentOffset(), offset+14.0)
+
+	_, err := m.Output()
+	assert.Nil(t, err)
+}
+
+func TestPdfJustPdf_TableOfContents_WhenNoBookmarks(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	m := newJustPdfTest(pdf, baseMathTest(), nil, baseTextTest(), nil, nil, nil, baseTableList())
+
+	// Act
+	m.TableOfContents()
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "CellFormat", 0)
+	pdf.AssertNumberOfCalls(t, "Ln", 0)
+}
+
 func TestPdfJustPdf_SetGetDebugMode(t *testing.T) {
 	// Arrange
 	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
@@ -435,6 +492,712 @@ func TestPdfJustPdf_Text(t *testing.T) {
 	}
 }
 
+func TestPdfJustPdf_Text_WhenShrinkToFit(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	text.On("GetFitSize", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(7.5)
+	pdf := basePdfTest(10, 10, 10, 10)
+	math := baseMathTest()
+	tableList := baseTableList()
+	m := newJustPdfTest(pdf, math, nil, text, nil, nil, nil, tableList)
+
+	// Act
+	m.Row(40, func() {
+		m.Col(func() {
+			m.Text("Text1", props.Text{Top: 5.0, ShrinkToFit: true})
+		})
+	})
+
+	// Assert
+	text.AssertCalled(t, "GetFitSize", "Text1", props.Text{Family: consts.Arial, Align: consts.Left, Top: 5.0, Size: 10.0, ShrinkToFit: true, MinSize: 4.0}, 1.0, 35.0)
+	text.AssertCalled(t, "Add", "Text1", props.Text{Family: consts.Arial, Align: consts.Left, Top: 5.0, Size: 7.5, ShrinkToFit: true, MinSize: 4.0}, 5.0, 0.0, 1.0)
+}
+
+func TestPdfJustPdf_Text_WhenVerticalAlign(t *testing.T) {
+	cases := []struct {
+		name          string
+		verticalAlign consts.Align
+		correction    float64
+		top           float64
+	}{
+		{"When vertical align is middle", consts.Middle, 11.5, 20.5},
+		{"When vertical align is bottom", consts.Bottom, 23.0, 32.0},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		text := &mocks.Text{}
+		text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(12.0)
+		font := &mocks.Font{}
+		font.On("GetScaleFactor").Return(2.5)
+		math := baseMathTest()
+		// Top is a padding, the text is aligned in the 35mm below it
+		math.On("GetAlignCorrection", c.verticalAlign, 35.0, 12.0).Return(c.correction)
+		pdf := basePdfTest(10, 10, 10, 10)
+		m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+		// Act
+		m.Row(40, func() {
+			m.Col(func() {
+				m.Text("Text1", props.Text{Top: 5.0, VerticalAlign: c.verticalAlign})
+			})
+		})
+
+		// Assert
+		text.AssertCalled(t, "Add", "Text1", props.Text{Family: consts.Arial, Align: consts.Left, Top: c.top, Size: 10.0, VerticalAlign: c.verticalAlign}, c.top, 0.0, 1.0)
+	}
+}
+
+func TestPdfJustPdf_Text_WhenRotated(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("AddRotated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	pdf := basePdfTest(10, 10, 10, 10)
+	math := baseMathTest()
+	m := newJustPdfTest(pdf, math, nil, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(40, func() {
+		m.Col(func() {
+			m.Text("Text1", props.Text{Top: 5.0, Rotation: 90, VerticalAlign: consts.Bottom})
+		})
+	})
+
+	// Assert
+	// The rotated text is fitted in the space of the row below Top
+	text.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "AddRotated", "Text1", mock.Anything, 5.0, 0.0, 1.0, 35.0)
+}
+
+func TestPdfJustPdf_AddUTF8Font(t *testing.T) {
+	// Arrange
+	font := &mocks.Font{}
+	font.On("AddUTF8Font", mock.Anything, mock.Anything, mock.Anything)
+	pdf := basePdfTest(10, 10, 10, 10)
+	m := newJustPdfTest(pdf, nil, font, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.AddUTF8Font("dejavu", consts.Bold, "dejavu-bold.ttf")
+
+	// Assert
+	font.AssertNumberOfCalls(t, "AddUTF8Font", 1)
+	font.AssertCalled(t, "AddUTF8Font", consts.Family("dejavu"), consts.Bold, "dejavu-bold.ttf")
+}
+
+func TestPdfJustPdf_Text_WhenLink(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(10.0)
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("GetStringWidth", mock.Anything, mock.Anything).Return(8.0)
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("LinkString", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	math := baseMathTest()
+	m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(40, func() {
+		m.ColSpace()
+		m.Col(func() {
+			m.Text("Text1", props.Text{Top: 5.0, Link: props.Link{URL: "https://example.com"}})
+		})
+	})
+	m.Row(40, func() {
+		m.ColSpace()
+		m.Col(func() {
+			m.Text("Text2", props.Text{Top: 5.0, Align: consts.Right, Link: props.Link{URL: "https://example.org"}})
+		})
+	})
+
+	// Assert
+	// The clickable area is the box of the text inside the cell
+	pdf.AssertNumberOfCalls(t, "LinkString", 2)
+	pdf.AssertCalled(t, "LinkString", 30.0, 10.0, 8.0, 10.0, "https://example.com")
+	pdf.AssertCalled(t, "LinkString", 42.0, 50.0, 8.0, 10.0, "https://example.org")
+}
+
+func TestPdfJustPdf_Text_WhenLinkAndTextWraps(t *testing.T) {
+	// Arrange
+	text := &mocks.Text{}
+	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(20.0)
+	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(2)
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("LinkString", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m := newJustPdfTest(pdf, baseMathTest(), font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(40, func() {
+		m.Col(func() {
+			m.Text("Text1 Text2", props.Text{Top: 5.0, Align: consts.Center, Link: props.Link{URL: "https://example.com"}})
+		})
+	})
+
+	// Assert
+	// Wrapped lines take the width of the cell
+	pdf.AssertCalled(t, "LinkString", 10.0, 10.0, 20.0, 20.0, "https://example.com")
+}
+
+func TestPdfJustPdf_Anchor(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("AddLink").Return(7)
+	pdf.On("SetLink", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("Link", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	math := baseMathTest()
+	m := newJustPdfTest(pdf, math, nil, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(20, func() {
+		m.Col(func() {
+			m.Link(props.Link{Anchor: "chapter"})
+		})
+	})
+	m.Anchor("chapter")
+	m.Row(30, func() {
+		m.Col(func() {
+			m.Link(props.Link{})
+		})
+	})
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "AddLink", 1)
+	pdf.AssertNumberOfCalls(t, "Link", 1)
+	pdf.AssertCalled(t, "Link", 10.0, 10.0, 20.0, 20.0, 7)
+	pdf.AssertNumberOfCalls(t, "SetLink", 1)
+	pdf.AssertCalled(t, "SetLink", 7, 30.0, 1)
+}
+
+func TestPdfJustPdf_Text_WhenReference(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	font.On("GetFamily").Return(consts.Arial)
+	font.On("IsUTF8", consts.Arial).Return(false)
+	math := baseMathTest()
+	math.On("GetAlignCorrection", mock.Anything, mock.Anything, mock.Anything).Return(0.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("AddPage")
+	pdf.On("AddLink").Return(7)
+	pdf.On("SetLink", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	pdf.On("Bookmark", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("SetPage", mock.Anything)
+	pdf.On("PageCount").Return(2)
+	pdf.On("Output", mock.Anything).Return(nil)
+	pdf.On("SetLeftMargin", mock.Anything)
+	pdf.On("SetRightMargin", mock.Anything)
+	m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+	reference := "See section {section:pricing} on page {ref:pricing}, {ref:missing}"
+
+	// Act
+	m.Row(20, func() {
+		m.Col(func() {
+			m.Text(reference, props.Text{Top: 5.0})
+		})
+	})
+	m.Heading(0, "Introduction")
+	m.Heading(1, "Pricing")
+	m.Anchor("pricing")
+	m.Row(50, func() {})
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 2)
+
+	// Act
+	_, _ = m.Output()
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 3)
+	text.AssertCalled(t, "Add", "See section 1.1 on page 2, ??", mock.Anything, 5.0, 0.0, 1.0)
+	pdf.AssertCalled(t, "SetLink", 7, mock.Anything, 2)
+	pdf.AssertCalled(t, "SetPage", 1)
+	pdf.AssertCalled(t, "SetPage", 2)
+}
+
+func TestPdfJustPdf_Text_WhenReferenceWithOtherMargins(t *testing.T) {
+	// Arrange
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	m.(*pdf.PdfJustPdf).Pdf.SetCompression(false)
+
+	// Act
+	m.Row(10, func() {
+		m.Col(func() {
+			m.SetLRMargins(30, 40)
+			m.Text("Page {ref:end}")
+			m.SetLRMargins(10, 10)
+		})
+	})
+	m.Anchor("end")
+
+	buffer, err := m.Output()
+
+	// Assert
+	assert.Nil(t, err)
+
+	// The text is written at the left margin of its cell, 30 mm are 85.04 pt
+	assert.Contains(t, buffer.String(), "BT 85.04 813.54 Td (Page 1) Tj ET")
+}
+
+func TestPdfJustPdf_Text_WhenReferenceAndRender(t *testing.T) {
+	// Arrange
+	header := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
+	render := func(cell string) pdf.JustPdf {
+		return pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+			m.TableList(header, [][]string{{cell, "", "", "", "", "", "", "", "", ""}})
+			m.Anchor("the end of the document")
+		})
+	}
+
+	// Act
+	withReference := render("See {ref:the end of the document}")
+	withPage := render("See 1")
+
+	// Assert
+	// The row is measured with the page found by the previous execution
+	assert.Equal(t, withPage.GetCurrentOffset(), withReference.GetCurrentOffset())
+}
+
+func TestPdfJustPdf_Text_WhenReferencesInComponents(t *testing.T) {
+	// Arrange
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+	m.(*pdf.PdfJustPdf).Pdf.SetCompression(false)
+
+	// Act
+	m.TableList([]string{"Topic", "Page"}, [][]string{{"Pricing", "on page {ref:pricing}"}})
+	m.List([]props.ListItem{{Text: "See section {section:pricing}"}})
+	m.Row(10, func() {
+		m.Col(func() {
+			m.Text("Noted" + m.Footnote("Detailed on page {ref:pricing}"))
+		})
+	})
+	m.Heading(0, "Introduction")
+	m.Heading(0, "Pricing, after section {section:intro}")
+	m.Anchor("pricing")
+	m.Heading(1, "Details")
+	m.Anchor("intro")
+
+	buffer, err := m.Output()
+
+	// Assert
+	assert.Nil(t, err)
+
+	content := buffer.String()
+	assert.NotContains(t, content, "{ref:")
+	assert.NotContains(t, content, "{section:")
+	assert.Contains(t, content, "on page 1")
+	assert.Contains(t, content, "See section 2")
+	assert.Contains(t, content, "Detailed on page 1")
+	assert.Contains(t, content, "2 Pricing, after section 2.1")
+}
+
+func TestPdfJustPdf_Heading(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	math := baseMathTest()
+	math.On("GetAlignCorrection", mock.Anything, mock.Anything, mock.Anything).Return(0.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Heading(0, "Chapter")
+	m.Heading(1, "Section")
+	m.Heading(1, "Section")
+	m.Heading(0, "Chapter")
+	m.Heading(3, "Skipped level")
+	m.SetHeadingStyle(1, props.Heading{Font: props.Font{Size: 10.0}})
+	m.Heading(1, "Styled")
+	m.Heading(1, "Not numbered", props.Heading{Font: props.Font{Size: 10.0}, Unnumbered: true})
+	m.Row(5, func() {})
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 7)
+	text.AssertCalled(t, "Add", "1 Chapter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "1.1 Section", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "1.2 Section", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "2 Chapter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "2.1 Skipped level", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "2.2 Styled", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	text.AssertCalled(t, "Add", "Not numbered", props.Text{Family: consts.Arial, Style: consts.Bold, Size: 10.0, Align: consts.Left, VerticalAlign: consts.Bottom, Top: 5.0}, mock.Anything, 0.0, 1.0)
+}
+
+func TestPdfJustPdf_Heading_WhenDoesntFitWithNextRow(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	math := baseMathTest()
+	math.On("GetAlignCorrection", mock.Anything, mock.Anything, mock.Anything).Return(0.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("AddPage")
+	m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(65, func() {})
+	m.Heading(0, "Chapter")
+	m.Row(10, func() {})
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "AddPage", 1)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+	assert.InDelta(t, m.GetCurrentOffset(), 20.8, 0.001)
+}
+
+func TestPdfJustPdf_KeepWithNext(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("AddPage")
+	m := newGridPartTest(pdf, baseMathTest(), nil, nil, nil, nil, nil, baseTableList())
+
+	calls := []string{}
+
+	// Act
+	m.Row(65, func() {})
+	m.KeepWithNext(10, func() {
+		m.Row(10, func() {
+			calls = append(calls, "kept")
+		})
+	})
+	m.Row(10, func() {
+		calls = append(calls, "next")
+	})
+
+	// Assert
+	// The kept Row doesn't fit with the next Row, so both are in the next page
+	assert.Equal(t, []string{"kept", "next"}, calls)
+	pdf.AssertNumberOfCalls(t, "AddPage", 1)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+	assert.Equal(t, m.GetCurrentOffset(), 20.0)
+}
+
+func TestPdfJustPdf_AddPage(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("AddPage")
+	m := newGridPartTest(pdf, baseMathTest(), nil, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.AddPage()
+	m.Row(10, func() {})
+	m.AddPage()
+	m.AddPage()
+
+	// Assert
+	// The pages without Rows are kept
+	pdf.AssertNumberOfCalls(t, "AddPage", 1)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+	assert.Equal(t, m.GetCurrentOffset(), 0.0)
+}
+
+func TestPdfJustPdf_Footnote(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(1.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("SetY", mock.Anything)
+	pdf.On("Line", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m := newJustPdfTest(pdf, baseMathTest(), font, text, nil, nil, nil, baseTableList())
+
+	mark := ""
+
+	// Act
+	m.Row(10, func() {
+		m.Col(func() {
+			mark = m.Footnote("Audited figures")
+		})
+	})
+	m.Row(60, func() {})
+
+	// Assert
+	assert.Equal(t, internal.Superscript("1"), mark)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+
+	// The footnotes area has the separator and the note with a font height of 8mm
+	pdf.AssertCalled(t, "SetY", 78.0)
+	pdf.AssertCalled(t, "Line", 10.0, 79.5, mock.AnythingOfType("float64"), 79.5)
+	text.AssertCalled(t, "Add", internal.Superscript("1")+" Audited figures", mock.Anything, 79.0, 0.0, 1.0)
+}
+
+func TestPdfJustPdf_Footnote_WhenDoesntFitInPage(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(1.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("SetY", mock.Anything)
+	pdf.On("Line", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m := newJustPdfTest(pdf, baseMathTest(), font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(75, func() {
+		m.Col(func() {
+			m.Footnote("Moved to the next page")
+		})
+	})
+	m.Row(10, func() {})
+
+	// Assert
+	text.AssertNotCalled(t, "Add", internal.Superscript("1")+" Moved to the next page", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Act
+	m.Row(60, func() {})
+
+	// Assert
+	assert.Equal(t, m.GetCurrentPage(), 2)
+	pdf.AssertNumberOfCalls(t, "Line", 1)
+	text.AssertCalled(t, "Add", internal.Superscript("1")+" Moved to the next page", mock.Anything, 79.0, 0.0, 1.0)
+}
+
+func TestPdfJustPdf_Footnote_WhenRenderedAndDoesntFitInPage(t *testing.T) {
+	// Act
+	m := pdf.Render(consts.Portrait, consts.A4, func(m pdf.JustPdf) {
+		m.Row(255, func() {})
+		m.Row(10, func() {
+			m.Col(func() {
+				m.Text("Referenced" + m.Footnote("Moved with its Row"))
+			})
+		})
+	})
+
+	// Assert
+	assert.Equal(t, m.GetCurrentPage(), 1)
+	assert.Equal(t, m.GetCurrentOffset(), 10.0)
+}
+
+func TestPdfJustPdf_Footnote_WhenNotRenderedAndDoesntFitInPage(t *testing.T) {
+	// Arrange
+	m := pdf.NewJustPdf(consts.Portrait, consts.A4)
+
+	// Act
+	m.Row(255, func() {})
+	m.Row(10, func() {
+		m.Col(func() {
+			m.Text("Referenced" + m.Footnote("Moved without its Row"))
+		})
+	})
+
+	// Assert
+	// In a single pass the Row stays in the page and only the note is moved to the next one
+	assert.Equal(t, m.GetCurrentPage(), 0)
+	assert.Equal(t, m.GetCurrentOffset(), 265.0)
+}
+
+func TestPdfJustPdf_Endnotes(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(1.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	m := newJustPdfTest(pdf, baseMathTest(), font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(10, func() {
+		m.Col(func() {
+			m.Footnote("First", props.Footnote{Endnote: true})
+			m.Footnote("Second", props.Footnote{Endnote: true})
+		})
+	})
+	m.Row(60, func() {})
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 0)
+
+	// Act
+	m.Endnotes()
+	m.Endnotes()
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 2)
+	text.AssertCalled(t, "Add", internal.Superscript("1")+" First", mock.Anything, 78.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", internal.Superscript("2")+" Second", mock.Anything, 8.0, 0.0, 1.0)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+}
+
+func TestPdfJustPdf_Index(t *testing.T) {
+	// Arrange
+	text := baseTextTest()
+	font := &mocks.Font{}
+	font.On("GetScaleFactor").Return(2.0)
+	math := baseMathTest()
+	math.On("GetAlignCorrection", mock.Anything, mock.Anything, mock.Anything).Return(0.0)
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("SetLeftMargin", mock.Anything)
+	pdf.On("SetRightMargin", mock.Anything)
+	m := newJustPdfTest(pdf, math, font, text, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Row(75, func() {
+		m.Col(func() {
+			m.IndexTerm("Table", "")
+			m.IndexTerm("anchor", "")
+			m.IndexTerm(" ", "ignored")
+		})
+	})
+	m.Row(10, func() {
+		m.Col(func() {
+			m.IndexTerm("Table", "")
+			m.IndexTerm("10 things", "")
+		})
+	})
+	m.IndexTerm("Table", "borders")
+	m.Index()
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 7)
+
+	// The left column has the first 4 lines
+	text.AssertCalled(t, "Add", "#", mock.Anything, 16.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "10 things, 2", mock.Anything, 20.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "A", mock.Anything, 26.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "anchor, 1", mock.Anything, 30.0, 0.0, 1.0)
+
+	// The right column starts after the column gap, subterms are indented
+	text.AssertCalled(t, "Add", "T", props.Text{Family: consts.Arial, Style: consts.Bold, Size: 12.0, Align: consts.Left, Extrapolate: true, Ellipsis: true}, 16.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "Table, 1\u20132", mock.Anything, 20.0, 0.0, 1.0)
+	text.AssertCalled(t, "Add", "borders, 2", mock.Anything, 25.0, 0.0, 1.0)
+	pdf.AssertCalled(t, "SetLeftMargin", 54.0)
+	pdf.AssertCalled(t, "SetLeftMargin", 58.0)
+	assert.Equal(t, m.GetCurrentPage(), 1)
+	assert.Equal(t, m.GetCurrentOffset(), 30.0)
+}
+
+func TestPdfJustPdf_List(t *testing.T) {
+	// Arrange
+	list := &mocks.List{}
+	list.On("Create", mock.Anything, mock.Anything)
+	m := &pdf.PdfJustPdf{
+		ListHelper: list,
+	}
+
+	items := []props.ListItem{{Text: "first"}, {Text: "second"}}
+	prop := props.List{Type: consts.Decimal}
+
+	// Act
+	m.List(items, prop)
+
+	// Assert
+	list.AssertNumberOfCalls(t, "Create", 1)
+	list.AssertCalled(t, "Create", items, prop)
+}
+
+func TestPdfJustPdf_Table(t *testing.T) {
+	// Arrange
+	tableList := &mocks.TableList{}
+	invalidImage := errors.New("invalid image")
+	tableList.On("CreateCells", mock.Anything, mock.Anything, mock.Anything).Return(invalidImage)
+	m := &pdf.PdfJustPdf{
+		TableListHelper: tableList,
+	}
+
+	header := [][]props.TableCell{
+		{{Text: "Product", RowSpan: 2}, {Text: "Q1", ColSpan: 3}},
+		{{Text: "Jan"}, {Text: "Feb"}, {Text: "Mar"}},
+	}
+	contents := [][]props.TableCell{
+		{{Text: "Coffee"}, {Text: "1"}, {Text: "2"}, {Text: "3"}},
+	}
+	prop := props.TableList{Line: true}
+
+	// Act
+	err := m.Table(header, contents, prop)
+
+	// Assert
+	assert.Equal(t, err, invalidImage)
+	tableList.AssertNumberOfCalls(t, "CreateCells", 1)
+	tableList.AssertCalled(t, "CreateCells", header, contents, prop)
+}
+
+func TestPdfJustPdf_TableFromStructs(t *testing.T) {
+	// Arrange
+	tableList := &mocks.TableList{}
+	tableList.On("CreateFromStructs", mock.Anything, mock.Anything).Return(errors.New("anyError"))
+	m := &pdf.PdfJustPdf{
+		TableListHelper: tableList,
+	}
+
+	slice := []struct{ Name string }{{Name: "Coffee"}}
+	prop := props.TableList{Line: true}
+
+	// Act
+	err := m.TableFromStructs(slice, prop)
+
+	// Assert
+	assert.NotNil(t, err)
+	tableList.AssertNumberOfCalls(t, "CreateFromStructs", 1)
+	tableList.AssertCalled(t, "CreateFromStructs", slice, prop)
+}
+
+func TestPdfJustPdf_TableFromCSV(t *testing.T) {
+	cases := []struct {
+		name    string
+		csv     string
+		prop    props.TableCSV
+		header  []string
+		records [][]string
+		err     bool
+	}{
+		{
+			"When the first record is the header",
+			"Product,Price\nCoffee,2.50\nTea,1.50\n",
+			props.TableCSV{},
+			nil,
+			[][]string{{"Product", "Price"}, {"Coffee", "2.50"}, {"Tea", "1.50"}},
+			false,
+		},
+		{
+			"When the header is defined and the comma is changed",
+			"Coffee;2.50\nTea\n",
+			props.TableCSV{Header: []string{"Product", "Price"}, Comma: ';'},
+			[]string{"Product", "Price"},
+			[][]string{{"Coffee", "2.50"}, {"Tea"}},
+			false,
+		},
+		{
+			"When the CSV is invalid",
+			"Coffee,\"2.50\nTea",
+			props.TableCSV{},
+			nil,
+			[][]string{},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		records := [][]string{}
+
+		tableList := &mocks.TableList{}
+		tableList.On("CreateFromIterator", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			next := args.Get(1).(func() ([]string, bool))
+			for record, ok := next(); ok; record, ok = next() {
+				records = append(records, record)
+			}
+		})
+		m := &pdf.PdfJustPdf{
+			TableListHelper: tableList,
+		}
+
+		// Act
+		err := m.TableFromCSV(strings.NewReader(c.csv), c.prop)
+
+		// Assert
+		assert.Equal(t, c.err, err != nil, c.name)
+		assert.Equal(t, c.records, records, c.name)
+		tableList.AssertCalled(t, "CreateFromIterator", c.header, mock.Anything, c.prop.Table)
+	}
+}
+
 func TestPdfJustPdf_FileImage(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -1196,6 +1959,50 @@ func TestPdfJustPdf_Line(t *testing.T) {
 	}
 }
 
+func TestPdfJustPdf_DrawLine(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("GetDrawColor").Return(0, 0, 0)
+	pdf.On("GetLineWidth").Return(0.2)
+	pdf.On("SetDrawColor", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("SetLineWidth", mock.Anything)
+	pdf.On("SetLineCapStyle", mock.Anything)
+	pdf.On("Line", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	m := newGridPartTest(pdf, baseMathTest(), nil, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.DrawLine(5.0, 2.0, 25.0, 2.0, props.Line{Width: 0.5, Color: color.Color{Red: 255}})
+
+	// Assert
+	pdf.AssertCalled(t, "Line", 15.0, 12.0, 35.0, 12.0)
+	pdf.AssertCalled(t, "SetDrawColor", 255, 0, 0)
+	pdf.AssertCalled(t, "SetLineWidth", 0.5)
+	pdf.AssertCalled(t, "SetLineCapStyle", "square")
+
+	// The color and the width of the next lines are restored
+	pdf.AssertCalled(t, "SetDrawColor", 0, 0, 0)
+	pdf.AssertCalled(t, "SetLineWidth", 0.2)
+}
+
+func TestPdfJustPdf_FillRect(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("Rect", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	m := newGridPartTest(pdf, baseMathTest(), nil, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.FillRect(5.0, 0.0, 20.0, 8.0, color.Color{Red: 200, Green: 200, Blue: 200})
+
+	// Assert
+	pdf.AssertCalled(t, "Rect", 15.0, 10.0, 20.0, 8.0, "F")
+	pdf.AssertCalled(t, "SetFillColor", 200, 200, 200)
+
+	// The background of the next cells is restored
+	pdf.AssertCalled(t, "SetFillColor", 255, 255, 255)
+}
+
 func TestPdfJustPdf_ColSpace(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -1453,6 +2260,65 @@ func TestPdfJustPdf_Output(t *testing.T) {
 	}
 }
 
+func TestPdfJustPdf_Bookmark(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return value })
+	pdf.On("SetPage", mock.Anything)
+	pdf.On("PageCount").Return(1)
+	pdf.On("Bookmark", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("Output", mock.Anything).Return(nil)
+	font := &mocks.Font{}
+	font.On("GetFamily").Return(consts.Arial)
+	font.On("IsUTF8", consts.Arial).Return(false)
+	m := newJustPdfTest(pdf, baseMathTest(), font, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Bookmark("Chapter 1", 0)
+	m.Row(20, func() {
+		m.Col(func() {
+			m.Bookmark("Section 1.1", 1)
+		})
+	})
+	m.Bookmark("Subsection 1.1.1", 3)
+	m.Row(30, func() {})
+	m.Bookmark("Chapter 2"+internal.Superscript("*"), -1)
+	_, _ = m.Output()
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "Bookmark", 4)
+	pdf.AssertCalled(t, "Bookmark", "Chapter 1", 0, 10.0)
+	pdf.AssertCalled(t, "Bookmark", "Section 1.1", 1, 10.0)
+	pdf.AssertCalled(t, "Bookmark", "Subsection 1.1.1", 2, 30.0)
+	pdf.AssertCalled(t, "Bookmark", "Chapter 2*", 0, 60.0)
+	pdf.AssertNumberOfCalls(t, "SetPage", 5)
+	pdf.AssertCalled(t, "SetPage", 1)
+}
+
+func TestPdfJustPdf_Bookmark_WhenNotLatin1(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	pdf.On("UnicodeTranslatorFromDescriptor", mock.Anything).Return(func(value string) string { return "translated " + value })
+	pdf.On("SetPage", mock.Anything)
+	pdf.On("PageCount").Return(1)
+	pdf.On("Bookmark", mock.Anything, mock.Anything, mock.Anything)
+	pdf.On("Output", mock.Anything).Return(nil)
+	font := &mocks.Font{}
+	font.On("GetFamily").Return(consts.Arial)
+	font.On("IsUTF8", consts.Arial).Return(false)
+	m := newJustPdfTest(pdf, baseMathTest(), font, nil, nil, nil, nil, baseTableList())
+
+	// Act
+	m.Bookmark("Café", 0)
+	m.Bookmark("Мир", 0)
+	_, _ = m.Output()
+
+	// Assert
+	// The title which the font can't translate is written as UTF-16
+	pdf.AssertCalled(t, "Bookmark", "translated Café", 0, 10.0)
+	pdf.AssertCalled(t, "Bookmark", "\xfe\xff\x04\x1c\x04\x38\x04\x40", 0, 10.0)
+}
+
 func TestPdfJustPdf_OutputFileAndClose(t *testing.T) {
 	cases := []struct {
 		name           string
@@ -1518,6 +2384,13 @@ func TestPdfJustPdf_OutputFileAndClose(t *testing.T) {
 
 func newJustPdfTest(fpdf *mocks.Pdf, math *mocks.Math, font *mocks.Font, text *mocks.Text,
 	signature *mocks.Signature, image *mocks.Image, code *mocks.Code, tableList *mocks.TableList) pdf.JustPdf {
+	return newGridPartTest(fpdf, math, font, text, signature, image, code, tableList)
+}
+
+// newGridPartTest builds the concrete PdfJustPdf, whose grid methods used by
+// TableList are not part of the public JustPdf interface.
+func newGridPartTest(fpdf *mocks.Pdf, math *mocks.Math, font *mocks.Font, text *mocks.Text,
+	signature *mocks.Signature, image *mocks.Image, code *mocks.Code, tableList *mocks.TableList) *pdf.PdfJustPdf {
 	m := &pdf.PdfJustPdf{
 		Pdf:             fpdf,
 		Math:            math,
@@ -1556,6 +2429,7 @@ func baseTextTest() *mocks.Text {
 	text := &mocks.Text{}
 	text.On("Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	text.On("GetLinesQuantity", mock.Anything, mock.Anything, mock.Anything).Return(1)
+	text.On("GetLinesHeight", mock.Anything, mock.Anything, mock.Anything).Return(5.0)
 	return text
 }
 
@@ -1757,6 +2631,142 @@ func TestPdfJustPdf_RegisterHeader(t *testing.T) {
 	}
 }
 
+func TestPdfJustPdf_RegisterRepeatedHeader(t *testing.T) {
+	cases := []struct {
+		name    string
+		closure bool
+		assert  func(t *testing.T, headerCalls int, repeatedHeaderCalls int)
+	}{
+		{
+			"Execute 6 times when contents continue in 6 new pages",
+			true,
+			func(t *testing.T, headerCalls int, repeatedHeaderCalls int) {
+				assert.Equal(t, headerCalls, 7)
+				assert.Equal(t, repeatedHeaderCalls, 6)
+			},
+		},
+		{
+			"When repeated header is nil not execute",
+			false,
+			func(t *testing.T, headerCalls int, repeatedHeaderCalls int) {
+				assert.Equal(t, headerCalls, 7)
+				assert.Equal(t, repeatedHeaderCalls, 0)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		// Arrange
+		pdf := basePdfTest(10, 10, 10, 10)
+		math := baseMathTest()
+		text := baseTextTest()
+		font := baseFontTest()
+		tableList := baseTableList()
+
+		headerCalls := 0
+		repeatedHeaderCalls := 0
+
+		pdf.On("PageCount").Return(0)
+		m := newGridPartTest(pdf, math, font, text, nil, nil, nil, tableList)
+
+		// The header moves the offset like a real one
+		m.RegisterHeader(func() {
+			headerCalls++
+			m.Row(10, func() {
+				m.ColSpace()
+			})
+		})
+
+		headers, contents := getContents()
+
+		// Act
+		m.Row(20, func() {
+			for _, header := range headers {
+				m.Col(func() {
+					m.Text(header)
+				})
+			}
+		})
+
+		if c.closure {
+			m.RegisterRepeatedHeader(func() {
+				// The repeated header is added after the header
+				assert.Equal(t, headerCalls, repeatedHeaderCalls+2)
+				repeatedHeaderCalls++
+			})
+		} else {
+			m.RegisterRepeatedHeader(nil)
+		}
+
+		for _, content := range contents {
+			m.Row(20, func() {
+				for _, txt := range content {
+					m.Col(func() {
+						m.Text(txt)
+					})
+				}
+			})
+		}
+
+		// Assert
+		c.assert(t, headerCalls, repeatedHeaderCalls)
+	}
+}
+
+func TestPdfJustPdf_RegisterRepeatedFooter(t *testing.T) {
+	// Arrange
+	pdf := basePdfTest(10, 10, 10, 10)
+	math := baseMathTest()
+	text := baseTextTest()
+	font := baseFontTest()
+	tableList := baseTableList()
+
+	pdf.On("PageCount").Return(0)
+	m := newGridPartTest(pdf, math, font, text, nil, nil, nil, tableList)
+
+	var calls []string
+
+	m.RegisterFooter(func() {
+		calls = append(calls, "footer")
+		m.Row(10, func() {
+			m.ColSpace()
+		})
+	})
+
+	m.RegisterRepeatedFooter(func() {
+		calls = append(calls, "repeated footer")
+		m.Row(10, func() {
+			m.ColSpace()
+		})
+	})
+
+	// Act
+	for i := 0; i < 4; i++ {
+		m.Row(30, func() {
+			m.ColSpace()
+		})
+	}
+
+	m.RegisterRepeatedFooter(nil)
+
+	for i := 0; i < 2; i++ {
+		m.Row(30, func() {
+			m.ColSpace()
+		})
+	}
+
+	// Assert
+	// Both are executed once to calculate its heights, with 20mm reserved
+	// to them only 2 Rows fit in a page of 80mm
+	assert.Equal(t, calls, []string{
+		"footer",
+		"repeated footer",
+		"repeated footer",
+		"footer",
+		"footer",
+	})
+}
+
 func TestPdfJustPdf_GetCurrentPage(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -13,6 +13,15 @@ type Proportion struct {
 	Height float64
 }
 
+// Link represents the destination of a clickable area, an external URL or an
+// internal anchor marked with JustPdf.Anchor. URL has priority over Anchor
+type Link struct {
+	// URL is an external destination, ex: https://example.com
+	URL string
+	// Anchor is the name of an internal destination
+	Anchor string
+}
+
 // Barcode represents properties from a barcode inside a cell
 type Barcode struct {
 	// Left is the space between the left cell boundary to the barcode, if center is false
@@ -28,6 +37,15 @@ type Barcode struct {
 	Proportion Proportion
 	// Center define that the barcode will be vertically and horizontally centralized
 	Center bool
+	// Align is the horizontal align of the barcode inside the cell (consts.Left, consts.Center
+	// or consts.Right) in the space after Left, ignored if center is true
+	Align consts.Align
+	// VerticalAlign is the vertical align of the barcode inside the cell (consts.Top, consts.Middle
+	// or consts.Bottom) in the space below Top, ignored if center is true
+	VerticalAlign consts.Align
+	// Rotation is the counter-clockwise angle in degrees which the barcode will be rotated
+	// around its center, the rotated barcode is sized to fit inside the cell
+	Rotation float64
 }
 
 // Rect represents properties from a rectangle (Image, QrCode or Barcode) inside a cell
@@ -42,6 +60,30 @@ type Rect struct {
 	Percent float64
 	// Center define that the barcode will be vertically and horizontally centralized
 	Center bool
+	// Align is the horizontal align of the rectangle inside the cell (consts.Left, consts.Center
+	// or consts.Right) in the space after Left, ignored if center is true
+	Align consts.Align
+	// VerticalAlign is the vertical align of the rectangle inside the cell (consts.Top, consts.Middle
+	// or consts.Bottom) in the space below Top, ignored if center is true
+	VerticalAlign consts.Align
+	// Rotation is the counter-clockwise angle in degrees which the rectangle will be rotated
+	// around its center, the rotated rectangle is sized to fit inside the cell
+	Rotation float64
+	// Link make the cell clickable
+	Link Link
+}
+
+// TabStop represents a position where the part of a text after a tab character ("\t") is aligned
+type TabStop struct {
+	// Position is the distance from the left cell boundary to the stop, limited to the cell width
+	Position float64
+	// Align of the part at the stop, consts.Left (default), consts.Right, consts.Center
+	// or consts.DecimalPoint, which places the decimal separator of a number at the stop
+	Align consts.Align
+	// Leader is repeated to fill the space before the part, ex: "." for dot leaders
+	Leader string
+	// DecimalSeparator is the separator found by consts.DecimalPoint, default "."
+	DecimalSeparator string
 }
 
 // Text represents properties from a Text inside a cell
@@ -58,11 +100,51 @@ type Text struct {
 	Color color.Color
 	// Align of the text
 	Align consts.Align
+	// VerticalAlign of the text inside the row, consts.Top (default), consts.Middle
+	// or consts.Bottom. When Middle or Bottom, the text is aligned in the space below Top
+	VerticalAlign consts.Align
 	// Extrapolate define if the text will automatically add a new line when
 	// text reach the right cell boundary
 	Extrapolate bool
 	// VerticalPadding define an additional space between lines
 	VerticalPadding float64
+	// Underline define that a line will be drawn below the text
+	Underline bool
+	// StrikeThrough define that a line will be drawn through the middle of the text
+	StrikeThrough bool
+	// Overline define that a line will be drawn above the text
+	Overline bool
+	// LetterSpacing is an additional space, in mm, after each character
+	LetterSpacing float64
+	// LineHeight is the distance between the baselines of two lines, when
+	// defined it replaces the font height plus VerticalPadding
+	LineHeight float64
+	// Rotation is the counter-clockwise angle in degrees which the text will be rotated.
+	// The lines are wrapped so the rotated text fits the cell below Top, and it is
+	// placed with Align and VerticalAlign
+	Rotation float64
+	// MaxLines define the maximum quantity of lines which a text can occupy,
+	// the remaining lines are discarded. Zero means no limit
+	MaxLines int
+	// Ellipsis define that a text cut by MaxLines or by the right cell boundary
+	// (when Extrapolate is true) will end with "..."
+	Ellipsis bool
+	// ShrinkToFit define that the font size will be reduced until the text
+	// fits the cell width and the row height, but never below MinSize
+	ShrinkToFit bool
+	// MinSize is the smallest font size which ShrinkToFit can use
+	MinSize float64
+	// Direction of the paragraph, consts.LeftToRight, consts.RightToLeft or consts.Auto.
+	// When defined the text is reordered with the Unicode bidirectional algorithm and
+	// Arabic letters are shaped, which requires an UTF-8 font. Without Align, the text
+	// starts at the side of its direction, with consts.Auto the one found in the text
+	Direction consts.Direction
+	// Link make the lines occupied by the text clickable
+	Link Link
+	// TabStops are the positions where the parts of a text separated by tab characters are
+	// aligned, each part goes to the first stop after the end of the previous one. A text
+	// with tab stops is written in a single line, starting at the left cell boundary
+	TabStops []TabStop
 }
 
 // Font represents properties from a text
@@ -73,6 +155,178 @@ type Font struct {
 	Style consts.Style
 	// Size of the text
 	Size float64
+	// Underline define that a line will be drawn below the text
+	Underline bool
+	// StrikeThrough define that a line will be drawn through the middle of the text
+	StrikeThrough bool
+	// Overline define that a line will be drawn above the text
+	Overline bool
+	// LetterSpacing is an additional space, in mm, after each character
+	LetterSpacing float64
+	// LineHeight is the distance between the baselines of two lines, when
+	// defined it replaces the font height plus VerticalPadding
+	LineHeight float64
+}
+
+// ColumnWidth represents the width of a column from a TableList
+type ColumnWidth struct {
+	// Type of the width, consts.Fixed, consts.Proportional (default) or consts.AutoFit
+	Type consts.WidthType
+	// Value is the width in mm of consts.Fixed or the weight of consts.Proportional, ex: a column
+	// with weight 2 is twice wider than a column with weight 1
+	Value float64
+	// Min is the smallest width in mm of consts.AutoFit
+	Min float64
+	// Max is the greatest width in mm of consts.AutoFit, zero means no limit
+	Max float64
+}
+
+// Cell is the content of a TableCell drawn instead of its Text, one of TextCell,
+// ImageCell, BarcodeCell, QrCodeCell or CheckboxCell
+type Cell interface {
+	// isCell restricts the contents to the ones which a table can draw
+	isCell()
+}
+
+// TextCell is a text with its own properties inside a TableCell, ex: bold, colored or
+// with superscript runs
+type TextCell struct {
+	// Text of the cell
+	Text string
+	// Prop is the custom properties of the text
+	Prop Text
+}
+
+// ImageCell is a base64 image inside a TableCell
+type ImageCell struct {
+	// Base64 is the image encoded
+	Base64 string
+	// Extension of the image, consts.Jpg or consts.Png
+	Extension consts.Extension
+	// Height is the space in mm reserved to the image, the row is at least this high
+	Height float64
+	// Prop is the custom properties of the image inside the space
+	Prop Rect
+}
+
+// BarcodeCell is a barcode inside a TableCell
+type BarcodeCell struct {
+	// Code of the barcode
+	Code string
+	// Height is the space in mm reserved to the barcode, the row is at least this high
+	Height float64
+	// Prop is the custom properties of the barcode inside the space
+	Prop Barcode
+}
+
+// QrCodeCell is a QR code inside a TableCell
+type QrCodeCell struct {
+	// Code of the QR code
+	Code string
+	// Height is the space in mm reserved to the QR code, the row is at least this high
+	Height float64
+	// Prop is the custom properties of the QR code inside the space
+	Prop Rect
+}
+
+// CheckboxCell is a box inside a TableCell, with a check mark when checked
+type CheckboxCell struct {
+	// Checked adds the check mark
+	Checked bool
+	// Size is the side in mm of the box
+	Size float64
+}
+
+func (TextCell) isCell()     {}
+func (ImageCell) isCell()    {}
+func (BarcodeCell) isCell()  {}
+func (QrCodeCell) isCell()   {}
+func (CheckboxCell) isCell() {}
+
+// TableCell represents a cell from a Table, which can be merged with the next cells
+type TableCell struct {
+	// Text of the cell
+	Text string
+	// ColSpan is the quantity of columns occupied by the cell, starting at its column
+	ColSpan int
+	// RowSpan is the quantity of rows occupied by the cell, starting at its row
+	RowSpan int
+	// Content is drawn instead of the Text, ex: an image or a barcode. Cells with
+	// content aren't measured by auto-fit columns and aren't aggregated by the footer
+	Content Cell
+}
+
+// Line represents properties from a line drawn inside a row, ex: the border of a cell
+type Line struct {
+	// Width of the line in mm
+	Width float64
+	// Color of the line
+	Color color.Color
+}
+
+// CellStyle represents the style of a content cell from a TableList, the
+// fields which are defined override the style of the table
+type CellStyle struct {
+	// BackgroundColor of the cell
+	BackgroundColor *color.Color
+	// Font of the text, its Family, Style and Size override the ones of ContentProp
+	Font *Font
+	// TextColor of the text
+	TextColor *color.Color
+	// Border is the line around the cell
+	Border *Line
+	// Borders override the sides of Border, each side with its own line
+	Borders *Borders
+}
+
+// Borders represents the lines of each side of a table or of a cell, the sides which are nil aren't drawn
+type Borders struct {
+	Top    *Line
+	Right  *Line
+	Bottom *Line
+	Left   *Line
+}
+
+// StyleRule represents a style applied to the content cells of a column whose
+// texts match a condition, ex: negative numbers in red
+type StyleRule struct {
+	// Column of the compared texts, starting at zero
+	Column int
+	// Operator of the comparison, consts.Equal by default. Texts which aren't numbers
+	// never match consts.LessThan, consts.LessOrEqual, consts.GreaterThan and consts.GreaterOrEqual
+	Operator consts.Operator
+	// Value compared with the texts
+	Value string
+	// Style of the cells which match
+	Style CellStyle
+	// Row applies the style to all cells in the row of a cell which matches
+	Row bool
+}
+
+// FooterCell represents a cell from the footer of a TableList
+type FooterCell struct {
+	// Text of the cell, used when it has no Aggregation
+	Text string
+	// Aggregation of the values of the column, ex: consts.Sum. Texts which
+	// aren't numbers are ignored, except by consts.Count
+	Aggregation consts.Aggregation
+}
+
+// TableFooter represents the footer of a TableList, a row after the contents with totals
+type TableFooter struct {
+	// Cells of the footer, one to each column
+	Cells []FooterCell
+	// Format of the aggregated values, like in fmt.Sprintf
+	Format string
+	// CarriedForward adds a row with the totals until the end of each page which the
+	// contents continue, and again at the top of the next page
+	CarriedForward bool
+	// CarriedForwardLabel is the text of the row at the end of a page, in the first
+	// column without aggregation
+	CarriedForwardLabel string
+	// BroughtForwardLabel is the text of the row at the top of the next page, in the
+	// first column without aggregation
+	BroughtForwardLabel string
 }
 
 // TableList represents properties from a TableList
@@ -103,6 +357,156 @@ type TableList struct {
 	HeaderContentSpace float64
 	// Line adds a line after every content-row to separate rows. The line's spaceHeight is set to 1.0
 	Line bool
+	// Direction of the table, with consts.RightToLeft the first column is drawn at the right
+	// and the texts are reordered as right-to-left paragraphs. With consts.Auto the direction
+	// is found in the texts of the header and, when known, of the contents
+	Direction consts.Direction
+	// Links make content cells clickable, each link is in the same position of its content
+	Links [][]Link
+	// ColumnWidths define the width of each column, columns without width share the space
+	// left like consts.Proportional columns with weight 1. A proportional column is at least
+	// 10mm wide for each unit of its weight, when the columns don't fit in the page all of
+	// them are narrowed, keeping its proportions
+	ColumnWidths []ColumnWidth
+	// RepeatHeader adds the header again in each page which the contents continue,
+	// after the header registered by RegisterHeader
+	RepeatHeader bool
+	// ContinuedCaption is a text added above the repeated header, ex: "(continued)"
+	ContinuedCaption string
+	// Footer adds a row after the contents, with totals of the columns
+	Footer *TableFooter
+	// RowStyles override the style of the content rows, each style is in the same position of its row
+	RowStyles []CellStyle
+	// StyleRules override the style of the content cells which match its conditions, the
+	// rules are applied in order after RowStyles
+	StyleRules []StyleRule
+	// CellStyles override the style of the content cells after StyleRules, each style
+	// is in the same position of its content
+	CellStyles [][]CellStyle
+	// Border is the preset of the lines drawn in the table, when Border or Borders
+	// are defined the lines of Line aren't drawn
+	Border consts.TableBorder
+	// BorderLine is the width and the color of the lines drawn by Border
+	BorderLine Line
+	// Borders override the sides of the table drawn by Border, each side with its own line
+	Borders *Borders
+	// Group adds a row above and a row after each group of contents next to each other with the same key
+	Group *TableGroup
+}
+
+// TableGroup represents properties from the groups of contents of a TableList
+type TableGroup struct {
+	// Key return the key of the group of a content, the text of the first column by default
+	Key func(content []string) string
+	// Header return the text of the row above each group, which is kept in the page of the first
+	// content of the group. By default the text is the key
+	Header func(key string) string
+	// Footer return the texts of the row after each group, one to each column. The cells of
+	// Subtotals with Text or Aggregation override them
+	Footer func(key string) []string
+	// Subtotals are the cells of the row after each group, with the aggregations of the contents of the group
+	Subtotals []FooterCell
+	// Format of the aggregated values, like in fmt.Sprintf
+	Format string
+	// PageBreak starts each group, after the first, in a new page
+	PageBreak bool
+}
+
+// TableCSV represents properties from a TableList created from a CSV
+type TableCSV struct {
+	// Table is the properties of the TableList
+	Table TableList
+	// Header of the table, when it's nil the first record is the header
+	Header []string
+	// Comma is the separator of the fields, ',' by default
+	Comma rune
+}
+
+// TableOfContents represents properties from a TableOfContents
+type TableOfContents struct {
+	// Font of the entries
+	Font Font
+	// RowHeight is the height of the row of each entry
+	RowHeight float64
+	// Indent is the additional left space of each bookmark level, 5 when zero.
+	// A negative value keeps the levels aligned
+	Indent float64
+	// Leader is the text repeated between the title and the page number
+	Leader string
+	// Levels is the quantity of bookmark levels listed, zero lists all levels
+	Levels int
+}
+
+// Heading represents properties from a Heading
+type Heading struct {
+	// Font of the heading
+	Font Font
+	// Color of the heading
+	Color color.Color
+	// RowHeight is the height of the row of the heading, the text is at its bottom
+	RowHeight float64
+	// Unnumbered define that the heading is written without its number,
+	// otherwise it starts with the number, ex: 2.3.1
+	Unnumbered bool
+}
+
+// ListItem represents an item from a List, which can have nested items
+type ListItem struct {
+	// Text of the item
+	Text string
+	// Items are nested below the item, with one more indentation level
+	Items []ListItem
+}
+
+// List represents properties from a List
+type List struct {
+	// Font of the items
+	Font Font
+	// Color of the items and markers
+	Color color.Color
+	// Type of the markers, ex: consts.Bullet, consts.Decimal and etc
+	Type consts.ListType
+	// Bullet is the glyph of consts.Bullet markers
+	Bullet string
+	// BulletFamily is the font family of the bullet, ex: consts.ZapBats with Bullet "l"
+	// for a filled circle. The default is the Font family
+	BulletFamily consts.Family
+	// Indent is the additional left space of each nested level, 5 when zero.
+	// A negative value keeps the levels aligned
+	Indent float64
+	// MarkerWidth is the space reserved to the marker, wrapped lines are aligned
+	// after it, 6 when zero. A negative value writes the text over the marker
+	MarkerWidth float64
+	// ItemSpacing is the space between the items, 1.5 when zero. A negative value means no space
+	ItemSpacing float64
+}
+
+// Footnote represents properties from a Footnote
+type Footnote struct {
+	// Font of the note, at the bottom of the page or at the endnotes
+	Font Font
+	// Color of the note
+	Color color.Color
+	// Endnote define that the note is collected to the endnotes,
+	// instead of the bottom of the page
+	Endnote bool
+}
+
+// Index represents properties from an Index
+type Index struct {
+	// Font of the terms and subterms
+	Font Font
+	// LetterFont is the font of the letter which starts each group of terms
+	LetterFont Font
+	// Color of the terms, subterms and letters
+	Color color.Color
+	// RowHeight is the height of the row of each term, subterm and letter
+	RowHeight float64
+	// Indent is the additional left space of the subterms, 4 when zero.
+	// A negative value keeps the subterms aligned with the terms
+	Indent float64
+	// ColumnGap is the space between the two columns, 8 when zero. A negative value means no space
+	ColumnGap float64
 }
 
 // MakeValid from Rect will make the properties from a rectangle reliable to fit inside a cell
@@ -172,7 +576,7 @@ func (s *Text) MakeValid() {
 	}
 
 	if s.Align == "" {
-		s.Align = consts.Left
+		s.Align = defaultAlign(s.Direction)
 	}
 
 	if s.Size == 0.0 {
@@ -186,6 +590,49 @@ func (s *Text) MakeValid() {
 	if s.VerticalPadding < 0 {
 		s.VerticalPadding = 0
 	}
+
+	if s.MaxLines < 0 {
+		s.MaxLines = 0
+	}
+
+	if s.LineHeight < 0 {
+		s.LineHeight = 0
+	}
+
+	if s.ShrinkToFit {
+		if s.MinSize <= 0.0 {
+			s.MinSize = 4.0
+		}
+
+		if s.MinSize > s.Size {
+			s.MinSize = s.Size
+		}
+	}
+
+	if len(s.TabStops) > 0 {
+		tabStops := make([]TabStop, len(s.TabStops))
+		for index, tabStop := range s.TabStops {
+			tabStop.MakeValid()
+			tabStops[index] = tabStop
+		}
+
+		s.TabStops = tabStops
+	}
+}
+
+// MakeValid from TabStop define default values for a TabStop
+func (s *TabStop) MakeValid() {
+	if s.Position < 0.0 {
+		s.Position = 0.0
+	}
+
+	if s.Align == "" {
+		s.Align = consts.Left
+	}
+
+	if s.DecimalSeparator == "" {
+		s.DecimalSeparator = "."
+	}
 }
 
 // MakeValid from Font define default values for a Signature
@@ -213,6 +660,11 @@ func (s *Font) ToTextProp(align consts.Align, top float64, extrapolate bool, ver
 		Top:             top,
 		Extrapolate:     extrapolate,
 		VerticalPadding: verticalPadding,
+		Underline:       s.Underline,
+		StrikeThrough:   s.StrikeThrough,
+		Overline:        s.Overline,
+		LetterSpacing:   s.LetterSpacing,
+		LineHeight:      s.LineHeight,
 	}
 
 	textProp.MakeValid()
@@ -235,7 +687,7 @@ func (s *TableList) MakeValid() {
 	}
 
 	if s.Align == "" {
-		s.Align = consts.Left
+		s.Align = defaultAlign(s.Direction)
 	}
 
 	if s.ContentProp.Size == 0.0 {
@@ -258,4 +710,381 @@ func (s *TableList) MakeValid() {
 		defaultColor := color.NewBlack()
 		s.HeaderColor = &defaultColor
 	}
+
+	if s.ContentFontColor == nil {
+		defaultColor := color.NewBlack()
+		s.ContentFontColor = &defaultColor
+	}
+
+	if len(s.ColumnWidths) > 0 {
+		columnWidths := make([]ColumnWidth, len(s.ColumnWidths))
+		for index, columnWidth := range s.ColumnWidths {
+			columnWidth.MakeValid()
+			columnWidths[index] = columnWidth
+		}
+
+		s.ColumnWidths = columnWidths
+	}
+
+	s.BorderLine.MakeValid()
+
+	if s.Borders != nil {
+		borders := *s.Borders
+		borders.MakeValid()
+		s.Borders = &borders
+	}
+
+	if len(s.StyleRules) > 0 {
+		styleRules := make([]StyleRule, len(s.StyleRules))
+		for index, styleRule := range s.StyleRules {
+			styleRule.MakeValid()
+			styleRules[index] = styleRule
+		}
+
+		s.StyleRules = styleRules
+	}
+
+	if s.Footer != nil {
+		footer := *s.Footer
+		footer.MakeValid()
+		s.Footer = &footer
+	}
+
+	if s.Group != nil {
+		group := *s.Group
+		group.MakeValid()
+		s.Group = &group
+	}
+}
+
+// MakeValid from TableGroup define default values for a TableGroup
+func (s *TableGroup) MakeValid() {
+	if s.Key == nil {
+		s.Key = func(content []string) string {
+			if len(content) == 0 {
+				return ""
+			}
+
+			return content[0]
+		}
+	}
+
+	if s.Header == nil {
+		s.Header = func(key string) string {
+			return key
+		}
+	}
+
+	if s.Format == "" {
+		s.Format = "%.2f"
+	}
+}
+
+// MakeValid from ColumnWidth define default values for a ColumnWidth
+func (s *ColumnWidth) MakeValid() {
+	if s.Type == "" {
+		s.Type = consts.Proportional
+	}
+
+	if s.Value < 0.0 {
+		s.Value = 0.0
+	}
+
+	if s.Type == consts.Proportional && s.Value == 0.0 {
+		s.Value = 1.0
+	}
+
+	if s.Min < 0.0 {
+		s.Min = 0.0
+	}
+
+	if s.Max < 0.0 {
+		s.Max = 0.0
+	}
+
+	if s.Max > 0.0 && s.Max < s.Min {
+		s.Max = s.Min
+	}
+}
+
+// MakeValid from Line define default values for a Line
+func (s *Line) MakeValid() {
+	if s.Width <= 0.0 {
+		s.Width = 0.2
+	}
+}
+
+// MakeValid from TableCSV define default values for a TableCSV
+func (s *TableCSV) MakeValid() {
+	if s.Comma == 0 {
+		s.Comma = ','
+	}
+}
+
+// MakeValid from Borders define default values for the sides of a Borders
+func (s *Borders) MakeValid() {
+	for _, side := range []**Line{&s.Top, &s.Right, &s.Bottom, &s.Left} {
+		if *side == nil {
+			continue
+		}
+
+		// The line is copied, so the one given by the caller isn't changed
+		line := **side
+		line.MakeValid()
+		*side = &line
+	}
+}
+
+// MakeValid from StyleRule define default values for a StyleRule
+func (s *StyleRule) MakeValid() {
+	if s.Operator == "" {
+		s.Operator = consts.Equal
+	}
+}
+
+// MakeValid from TableFooter define default values for a TableFooter
+func (s *TableFooter) MakeValid() {
+	if s.Format == "" {
+		s.Format = "%.2f"
+	}
+
+	if s.CarriedForwardLabel == "" {
+		s.CarriedForwardLabel = "Carried forward"
+	}
+
+	if s.BroughtForwardLabel == "" {
+		s.BroughtForwardLabel = "Brought forward"
+	}
+}
+
+// MakeValid from TextCell define default values for a TextCell
+func (s *TextCell) MakeValid() {
+	s.Prop.MakeValid()
+}
+
+// MakeValid from ImageCell define default values for an ImageCell
+func (s *ImageCell) MakeValid() {
+	if s.Height <= 0.0 {
+		s.Height = 20.0
+	}
+
+	s.Prop.MakeValid()
+}
+
+// MakeValid from BarcodeCell define default values for a BarcodeCell
+func (s *BarcodeCell) MakeValid() {
+	if s.Height <= 0.0 {
+		s.Height = 10.0
+	}
+
+	s.Prop.MakeValid()
+}
+
+// MakeValid from QrCodeCell define default values for a QrCodeCell
+func (s *QrCodeCell) MakeValid() {
+	if s.Height <= 0.0 {
+		s.Height = 20.0
+	}
+
+	s.Prop.MakeValid()
+}
+
+// MakeValid from CheckboxCell define default values for a CheckboxCell
+func (s *CheckboxCell) MakeValid() {
+	if s.Size <= 0.0 {
+		s.Size = 4.0
+	}
+}
+
+// MakeValid from TableCell define default values for a TableCell
+func (s *TableCell) MakeValid() {
+	if s.ColSpan < 1 {
+		s.ColSpan = 1
+	}
+
+	if s.RowSpan < 1 {
+		s.RowSpan = 1
+	}
+}
+
+// defaultAlign is the horizontal align used when none is defined,
+// right-to-left paragraphs starts at the right. With consts.Auto the
+// align is kept empty, it is chosen when the text is written and its
+// direction is known
+func defaultAlign(direction consts.Direction) consts.Align {
+	switch direction {
+	case consts.RightToLeft:
+		return consts.Right
+	case consts.Auto:
+		return ""
+	default:
+		return consts.Left
+	}
+}
+
+// MakeValid from TableOfContents define default values for a TableOfContents
+func (s *TableOfContents) MakeValid() {
+	if s.Font.Family == "" {
+		s.Font.Family = consts.Arial
+	}
+
+	if s.Font.Style == "" {
+		s.Font.Style = consts.Normal
+	}
+
+	if s.Font.Size == 0.0 {
+		s.Font.Size = 10.0
+	}
+
+	if s.RowHeight <= 0.0 {
+		s.RowHeight = 7.0
+	}
+
+	if s.Indent == 0.0 {
+		s.Indent = 5.0
+	}
+
+	if s.Indent < 0.0 {
+		s.Indent = 0.0
+	}
+
+	if s.Leader == "" {
+		s.Leader = "."
+	}
+
+	if s.Levels < 0 {
+		s.Levels = 0
+	}
+}
+
+// MakeValid from Heading define default values for a Heading
+func (s *Heading) MakeValid() {
+	if s.Font.Family == "" {
+		s.Font.Family = consts.Arial
+	}
+
+	if s.Font.Style == "" {
+		s.Font.Style = consts.Bold
+	}
+
+	if s.Font.Size == 0.0 {
+		s.Font.Size = 12.0
+	}
+
+	if s.RowHeight <= 0.0 {
+		s.RowHeight = s.Font.Size * 0.6
+	}
+}
+
+// MakeValid from List define default values for a List
+func (s *List) MakeValid() {
+	if s.Font.Family == "" {
+		s.Font.Family = consts.Arial
+	}
+
+	if s.Font.Style == "" {
+		s.Font.Style = consts.Normal
+	}
+
+	if s.Font.Size == 0.0 {
+		s.Font.Size = 10.0
+	}
+
+	if s.Type == "" {
+		s.Type = consts.Bullet
+	}
+
+	if s.Bullet == "" {
+		s.Bullet = "\u2022"
+	}
+
+	if s.BulletFamily == "" {
+		s.BulletFamily = s.Font.Family
+	}
+
+	if s.Indent == 0.0 {
+		s.Indent = 5.0
+	}
+
+	if s.Indent < 0.0 {
+		s.Indent = 0.0
+	}
+
+	if s.MarkerWidth == 0.0 {
+		s.MarkerWidth = 6.0
+	}
+
+	if s.MarkerWidth < 0.0 {
+		s.MarkerWidth = 0.0
+	}
+
+	if s.ItemSpacing == 0.0 {
+		s.ItemSpacing = 1.5
+	}
+
+	if s.ItemSpacing < 0.0 {
+		s.ItemSpacing = 0.0
+	}
+}
+
+// MakeValid from Footnote define default values for a Footnote
+func (s *Footnote) MakeValid() {
+	if s.Font.Family == "" {
+		s.Font.Family = consts.Arial
+	}
+
+	if s.Font.Style == "" {
+		s.Font.Style = consts.Normal
+	}
+
+	if s.Font.Size == 0.0 {
+		s.Font.Size = 8.0
+	}
+}
+
+// MakeValid from Index define default values for an Index
+func (s *Index) MakeValid() {
+	if s.Font.Family == "" {
+		s.Font.Family = consts.Arial
+	}
+
+	if s.Font.Style == "" {
+		s.Font.Style = consts.Normal
+	}
+
+	if s.Font.Size == 0.0 {
+		s.Font.Size = 10.0
+	}
+
+	if s.LetterFont.Family == "" {
+		s.LetterFont.Family = s.Font.Family
+	}
+
+	if s.LetterFont.Style == "" {
+		s.LetterFont.Style = consts.Bold
+	}
+
+	if s.LetterFont.Size == 0.0 {
+		s.LetterFont.Size = s.Font.Size + 2.0
+	}
+
+	if s.RowHeight <= 0.0 {
+		s.RowHeight = 5.0
+	}
+
+	if s.Indent == 0.0 {
+		s.Indent = 4.0
+	}
+
+	if s.Indent < 0.0 {
+		s.Indent = 0.0
+	}
+
+	if s.ColumnGap == 0.0 {
+		s.ColumnGap = 8.0
+	}
+
+	if s.ColumnGap < 0.0 {
+		s.ColumnGap = 0.0
+	}
 }
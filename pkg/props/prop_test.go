@@ -237,6 +237,91 @@ func TestText_MakeValid(t *testing.T) {
 				assert.Equal(t, prop.VerticalPadding, 0.0)
 			},
 		},
+		{
+			"When line height is less than 0, should become 0",
+			&props.Text{
+				LineHeight: -1.0,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.LineHeight, 0.0)
+			},
+		},
+		{
+			"When max lines is less than 0, should become 0",
+			&props.Text{
+				MaxLines: -1,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MaxLines, 0)
+			},
+		},
+		{
+			"When shrink to fit and min size is not defined, should define 4.0",
+			&props.Text{
+				ShrinkToFit: true,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinSize, 4.0)
+			},
+		},
+		{
+			"When shrink to fit and min size is greater than size, should become size",
+			&props.Text{
+				ShrinkToFit: true,
+				Size:        8.0,
+				MinSize:     12.0,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinSize, 8.0)
+			},
+		},
+		{
+			"When not shrink to fit, should keep min size",
+			&props.Text{
+				MinSize: 12.0,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.MinSize, 12.0)
+			},
+		},
+		{
+			"When align is not defined and direction is right-to-left, should define Right",
+			&props.Text{
+				Direction: consts.RightToLeft,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Align, consts.Right)
+			},
+		},
+		{
+			"When align is not defined and direction is auto, should keep it to be chosen with the text",
+			&props.Text{
+				Direction: consts.Auto,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Align, consts.Align(""))
+			},
+		},
+		{
+			"When align is defined and direction is right-to-left, should keep align",
+			&props.Text{
+				Align:     consts.Center,
+				Direction: consts.RightToLeft,
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.Align, consts.Center)
+			},
+		},
+		{
+			"When tab stops are defined, should define its defaults",
+			&props.Text{
+				TabStops: []props.TabStop{{Position: -1.0}, {Position: 20.0, Align: consts.Right, DecimalSeparator: ","}},
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, prop.TabStops[0], props.TabStop{Position: 0.0, Align: consts.Left, DecimalSeparator: "."})
+				assert.Equal(t, prop.TabStops[1], props.TabStop{Position: 20.0, Align: consts.Right, DecimalSeparator: ","})
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -289,6 +374,162 @@ func TestFontProp_MakeValid(t *testing.T) {
 	}
 }
 
+func TestFontProp_ToTextProp(t *testing.T) {
+	// Arrange
+	font := props.Font{
+		Family:        consts.Courier,
+		Style:         consts.Italic,
+		Size:          9.0,
+		Underline:     true,
+		StrikeThrough: true,
+		Overline:      true,
+		LetterSpacing: 0.5,
+		LineHeight:    6.0,
+	}
+
+	// Act
+	text := font.ToTextProp(consts.Right, 2.0, true, 1.0)
+
+	// Assert
+	assert.Equal(t, text, props.Text{
+		Family:          consts.Courier,
+		Style:           consts.Italic,
+		Size:            9.0,
+		Align:           consts.Right,
+		Top:             2.0,
+		Extrapolate:     true,
+		VerticalPadding: 1.0,
+		Underline:       true,
+		StrikeThrough:   true,
+		Overline:        true,
+		LetterSpacing:   0.5,
+		LineHeight:      6.0,
+	})
+}
+
+func TestCell_MakeValid(t *testing.T) {
+	// Arrange
+	image := props.ImageCell{}
+	barcode := props.BarcodeCell{}
+	qrCode := props.QrCodeCell{}
+	checkbox := props.CheckboxCell{}
+
+	// Act
+	image.MakeValid()
+	barcode.MakeValid()
+	qrCode.MakeValid()
+	checkbox.MakeValid()
+
+	// Assert
+	assert.Equal(t, image.Height, 20.0)
+	assert.Equal(t, image.Prop.Percent, 100.0)
+	assert.Equal(t, barcode.Height, 10.0)
+	assert.Equal(t, qrCode.Height, 20.0)
+	assert.Equal(t, checkbox.Size, 4.0)
+}
+
+func TestStyleRule_MakeValid(t *testing.T) {
+	// Arrange
+	styleRule := props.StyleRule{}
+	line := props.Line{}
+
+	// Act
+	styleRule.MakeValid()
+	line.MakeValid()
+
+	// Assert
+	assert.Equal(t, styleRule.Operator, consts.Equal)
+	assert.Equal(t, line.Width, 0.2)
+}
+
+func TestTableCSV_MakeValid(t *testing.T) {
+	// Arrange
+	tableCSV := props.TableCSV{}
+
+	// Act
+	tableCSV.MakeValid()
+
+	// Assert
+	assert.Equal(t, tableCSV.Comma, ',')
+}
+
+func TestTableGroup_MakeValid(t *testing.T) {
+	// Arrange
+	tableGroup := props.TableGroup{}
+
+	// Act
+	tableGroup.MakeValid()
+
+	// Assert
+	assert.Equal(t, tableGroup.Key([]string{"North", "1"}), "North")
+	assert.Equal(t, tableGroup.Key([]string{}), "")
+	assert.Equal(t, tableGroup.Header("North"), "North")
+	assert.Equal(t, tableGroup.Format, "%.2f")
+}
+
+func TestBorders_MakeValid(t *testing.T) {
+	// Arrange
+	top := props.Line{}
+	borders := props.Borders{Top: &top}
+
+	// Act
+	borders.MakeValid()
+
+	// Assert
+	assert.Equal(t, borders.Top.Width, 0.2)
+	assert.Nil(t, borders.Bottom)
+
+	// The line given by the caller isn't changed
+	assert.Equal(t, top.Width, 0.0)
+}
+
+func TestTableCell_MakeValid(t *testing.T) {
+	cases := []struct {
+		name      string
+		tableCell *props.TableCell
+		assert    func(t *testing.T, m *props.TableCell)
+	}{
+		{
+			"When ColSpan and RowSpan are not defined",
+			&props.TableCell{},
+			func(t *testing.T, m *props.TableCell) {
+				assert.Equal(t, m.ColSpan, 1)
+				assert.Equal(t, m.RowSpan, 1)
+			},
+		},
+		{
+			"When ColSpan and RowSpan are negative",
+			&props.TableCell{
+				ColSpan: -2,
+				RowSpan: -2,
+			},
+			func(t *testing.T, m *props.TableCell) {
+				assert.Equal(t, m.ColSpan, 1)
+				assert.Equal(t, m.RowSpan, 1)
+			},
+		},
+		{
+			"When ColSpan and RowSpan are defined",
+			&props.TableCell{
+				ColSpan: 3,
+				RowSpan: 2,
+			},
+			func(t *testing.T, m *props.TableCell) {
+				assert.Equal(t, m.ColSpan, 3)
+				assert.Equal(t, m.RowSpan, 2)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		// Act
+		c.tableCell.MakeValid()
+
+		// Assert
+		c.assert(t, c.tableCell)
+	}
+}
+
 func TestTableListProp_MakeValid(t *testing.T) {
 	cases := []struct {
 		name          string
@@ -310,6 +551,24 @@ func TestTableListProp_MakeValid(t *testing.T) {
 				assert.Equal(t, m.ContentProp.Style, consts.Normal)
 			},
 		},
+		{
+			"When Align is not defined and Direction is right-to-left",
+			&props.TableList{
+				Direction: consts.RightToLeft,
+			},
+			func(t *testing.T, m *props.TableList) {
+				assert.Equal(t, m.Align, consts.Right)
+			},
+		},
+		{
+			"When Align is not defined and Direction is auto",
+			&props.TableList{
+				Direction: consts.Auto,
+			},
+			func(t *testing.T, m *props.TableList) {
+				assert.Equal(t, m.Align, consts.Align(""))
+			},
+		},
 		{
 			"When HeaderProp.Size is 0.0",
 			&props.TableList{
@@ -394,6 +653,34 @@ func TestTableListProp_MakeValid(t *testing.T) {
 				assert.Equal(t, m.HeaderContentSpace, 4.0)
 			},
 		},
+		{
+			"When Footer is defined",
+			&props.TableList{
+				Footer: &props.TableFooter{},
+			},
+			func(t *testing.T, m *props.TableList) {
+				assert.Equal(t, m.Footer.Format, "%.2f")
+				assert.Equal(t, m.Footer.CarriedForwardLabel, "Carried forward")
+				assert.Equal(t, m.Footer.BroughtForwardLabel, "Brought forward")
+			},
+		},
+		{
+			"When ColumnWidths have invalid values",
+			&props.TableList{
+				ColumnWidths: []props.ColumnWidth{
+					{},
+					{Type: consts.Fixed, Value: -10.0},
+					{Type: consts.AutoFit, Min: -1.0, Max: -1.0},
+				},
+			},
+			func(t *testing.T, m *props.TableList) {
+				assert.Equal(t, m.ColumnWidths[0].Type, consts.Proportional)
+				assert.Equal(t, m.ColumnWidths[0].Value, 1.0)
+				assert.Equal(t, m.ColumnWidths[1].Value, 0.0)
+				assert.Equal(t, m.ColumnWidths[2].Min, 0.0)
+				assert.Equal(t, m.ColumnWidths[2].Max, 0.0)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -404,3 +691,254 @@ func TestTableListProp_MakeValid(t *testing.T) {
 		c.assert(t, c.tableListProp)
 	}
 }
+
+func TestTableOfContentsProp_MakeValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		prop   *props.TableOfContents
+		assert func(t *testing.T, prop *props.TableOfContents)
+	}{
+		{
+			"When nothing is defined, should define defaults",
+			&props.TableOfContents{},
+			func(t *testing.T, prop *props.TableOfContents) {
+				assert.Equal(t, prop.Font.Family, consts.Arial)
+				assert.Equal(t, prop.Font.Style, consts.Normal)
+				assert.Equal(t, prop.Font.Size, 10.0)
+				assert.Equal(t, prop.RowHeight, 7.0)
+				assert.Equal(t, prop.Indent, 5.0)
+				assert.Equal(t, prop.Leader, ".")
+				assert.Equal(t, prop.Levels, 0)
+			},
+		},
+		{
+			"When indent is negative, should be zero",
+			&props.TableOfContents{
+				Indent: -1.0,
+			},
+			func(t *testing.T, prop *props.TableOfContents) {
+				assert.Equal(t, prop.Indent, 0.0)
+			},
+		},
+		{
+			"When levels is negative, should list all levels",
+			&props.TableOfContents{
+				Levels: -1,
+			},
+			func(t *testing.T, prop *props.TableOfContents) {
+				assert.Equal(t, prop.Levels, 0)
+			},
+		},
+		{
+			"When defined, should keep values",
+			&props.TableOfContents{
+				RowHeight: 10.0,
+				Indent:    3.0,
+				Leader:    "-",
+				Levels:    2,
+			},
+			func(t *testing.T, prop *props.TableOfContents) {
+				assert.Equal(t, prop.RowHeight, 10.0)
+				assert.Equal(t, prop.Indent, 3.0)
+				assert.Equal(t, prop.Leader, "-")
+				assert.Equal(t, prop.Levels, 2)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.prop.MakeValid()
+		c.assert(t, c.prop)
+	}
+}
+
+func TestHeadingProp_MakeValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		prop   *props.Heading
+		assert func(t *testing.T, prop *props.Heading)
+	}{
+		{
+			"When nothing is defined, should define defaults",
+			&props.Heading{},
+			func(t *testing.T, prop *props.Heading) {
+				assert.Equal(t, prop.Font.Family, consts.Arial)
+				assert.Equal(t, prop.Font.Style, consts.Bold)
+				assert.Equal(t, prop.Font.Size, 12.0)
+				assert.InDelta(t, prop.RowHeight, 7.2, 0.001)
+			},
+		},
+		{
+			"When row height is not defined, should be proportional to the size",
+			&props.Heading{
+				Font: props.Font{Size: 20.0},
+			},
+			func(t *testing.T, prop *props.Heading) {
+				assert.Equal(t, prop.RowHeight, 12.0)
+			},
+		},
+		{
+			"When row height is defined, should keep it",
+			&props.Heading{
+				RowHeight: 15.0,
+			},
+			func(t *testing.T, prop *props.Heading) {
+				assert.Equal(t, prop.RowHeight, 15.0)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.prop.MakeValid()
+		c.assert(t, c.prop)
+	}
+}
+
+func TestListProp_MakeValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		prop   *props.List
+		assert func(t *testing.T, prop *props.List)
+	}{
+		{
+			"When nothing is defined, should define defaults",
+			&props.List{},
+			func(t *testing.T, prop *props.List) {
+				assert.Equal(t, prop.Font.Family, consts.Arial)
+				assert.Equal(t, prop.Font.Style, consts.Normal)
+				assert.Equal(t, prop.Font.Size, 10.0)
+				assert.Equal(t, prop.Type, consts.Bullet)
+				assert.Equal(t, prop.Bullet, "•")
+				assert.Equal(t, prop.BulletFamily, consts.Arial)
+				assert.Equal(t, prop.Indent, 5.0)
+				assert.Equal(t, prop.MarkerWidth, 6.0)
+				assert.Equal(t, prop.ItemSpacing, 1.5)
+			},
+		},
+		{
+			"When spaces are negative, should be zero",
+			&props.List{
+				Indent:      -1.0,
+				MarkerWidth: -1.0,
+				ItemSpacing: -1.0,
+			},
+			func(t *testing.T, prop *props.List) {
+				assert.Equal(t, prop.Indent, 0.0)
+				assert.Equal(t, prop.MarkerWidth, 0.0)
+				assert.Equal(t, prop.ItemSpacing, 0.0)
+			},
+		},
+		{
+			"When bullet family is not defined, should use the font family",
+			&props.List{
+				Font: props.Font{Family: consts.Courier},
+			},
+			func(t *testing.T, prop *props.List) {
+				assert.Equal(t, prop.BulletFamily, consts.Courier)
+			},
+		},
+		{
+			"When bullet is defined, should keep it",
+			&props.List{
+				Bullet:       "l",
+				BulletFamily: consts.ZapBats,
+			},
+			func(t *testing.T, prop *props.List) {
+				assert.Equal(t, prop.Bullet, "l")
+				assert.Equal(t, prop.BulletFamily, consts.ZapBats)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.prop.MakeValid()
+		c.assert(t, c.prop)
+	}
+}
+
+func TestFootnoteProp_MakeValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		prop   *props.Footnote
+		assert func(t *testing.T, prop *props.Footnote)
+	}{
+		{
+			"When nothing is defined, should define defaults",
+			&props.Footnote{},
+			func(t *testing.T, prop *props.Footnote) {
+				assert.Equal(t, prop.Font.Family, consts.Arial)
+				assert.Equal(t, prop.Font.Style, consts.Normal)
+				assert.Equal(t, prop.Font.Size, 8.0)
+				assert.False(t, prop.Endnote)
+			},
+		},
+		{
+			"When font is defined, should keep it",
+			&props.Footnote{
+				Font: props.Font{Family: consts.Courier, Style: consts.Italic, Size: 9.0},
+			},
+			func(t *testing.T, prop *props.Footnote) {
+				assert.Equal(t, prop.Font.Family, consts.Courier)
+				assert.Equal(t, prop.Font.Style, consts.Italic)
+				assert.Equal(t, prop.Font.Size, 9.0)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.prop.MakeValid()
+		c.assert(t, c.prop)
+	}
+}
+
+func TestIndexProp_MakeValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		prop   *props.Index
+		assert func(t *testing.T, prop *props.Index)
+	}{
+		{
+			"When nothing is defined, should define defaults",
+			&props.Index{},
+			func(t *testing.T, prop *props.Index) {
+				assert.Equal(t, prop.Font.Family, consts.Arial)
+				assert.Equal(t, prop.Font.Style, consts.Normal)
+				assert.Equal(t, prop.Font.Size, 10.0)
+				assert.Equal(t, prop.LetterFont.Family, consts.Arial)
+				assert.Equal(t, prop.LetterFont.Style, consts.Bold)
+				assert.Equal(t, prop.LetterFont.Size, 12.0)
+				assert.Equal(t, prop.RowHeight, 5.0)
+				assert.Equal(t, prop.Indent, 4.0)
+				assert.Equal(t, prop.ColumnGap, 8.0)
+			},
+		},
+		{
+			"When font is defined, letters should follow its family and size",
+			&props.Index{
+				Font:      props.Font{Family: consts.Courier, Size: 8.0},
+				RowHeight: 4.0,
+			},
+			func(t *testing.T, prop *props.Index) {
+				assert.Equal(t, prop.LetterFont.Family, consts.Courier)
+				assert.Equal(t, prop.LetterFont.Size, 10.0)
+				assert.Equal(t, prop.RowHeight, 4.0)
+			},
+		},
+		{
+			"When spaces are negative, should be zero",
+			&props.Index{
+				Indent:    -1.0,
+				ColumnGap: -1.0,
+			},
+			func(t *testing.T, prop *props.Index) {
+				assert.Equal(t, prop.Indent, 0.0)
+				assert.Equal(t, prop.ColumnGap, 0.0)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.prop.MakeValid()
+		c.assert(t, c.prop)
+	}
+}